@@ -1,211 +1,480 @@
 package dataframe
 
 import (
-	"sort"
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
 	"time"
 )
 
 func (col *Float) Min() float64 {
-	if len(col.data) == 0 {
-		panic("empty column")
-	}
-	min := col.data[0]
-	for _, v := range col.data {
-		if v < min {
-			min = v
-		}
-	}
-	return min
+	return minValue(col.data, &col.nulls)
 }
 
 func (col *Float) Max() float64 {
-	if len(col.data) == 0 {
-		panic("empty column")
-	}
-	max := col.data[0]
-	for _, v := range col.data {
-		if v > max {
-			max = v
-		}
-	}
-	return max
+	return maxValue(col.data, &col.nulls)
+}
+
+func (col *Float) Sum() float64 {
+	return sumValues[float64](col.data, &col.nulls)
 }
 
 func (col *Float) Mean() float64 {
-	if len(col.data) == 0 {
-		panic("empty column")
-	}
-	sum := float64(0)
-	for _, v := range col.data {
-		sum += v
-	}
-	return float64(sum) / float64(len(col.data))
+	return col.Sum() / float64(nonNull(&col.nulls, len(col.data)))
 }
 
 func (col *Float) Median() float64 {
-	if len(col.data) == 0 {
-		panic("empty column")
-	}
-	sorted := append([]float64{}, col.data...)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i] < sorted[j]
-	})
-	n := len(sorted)
-	if n%2 == 0 {
-		return float64(sorted[n/2-1]+sorted[n/2]) / 2
-	}
-	return float64(sorted[n/2])
+	lo, hi := middleValues(col.data, &col.nulls)
+	return (lo + hi) / 2
 }
 
-func (col *Float) Sum() float64 {
-	if len(col.data) == 0 {
+func (col *Float) Std() float64 {
+	return stdValues(col.data, &col.nulls)
+}
+
+func (col *Float) Unique() IColumn {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &Float{Column[float64]{data: data, nulls: nulls}}
+}
+
+func (col *Int) Min() int64 {
+	return minValue(col.data, &col.nulls)
+}
+
+func (col *Int) Max() int64 {
+	return maxValue(col.data, &col.nulls)
+}
+
+// Sum wraps around on overflow like Go integers do. TrySum reports
+// ErrOverflow instead.
+func (col *Int) Sum() int64 {
+	return sumValues[int64](col.data, &col.nulls)
+}
+
+// Mean returns 0 for a column without values. TryMean reports
+// ErrEmptyColumn instead.
+func (col *Int) Mean() float64 {
+	n := len(col.data) - col.nulls.count
+	if n == 0 {
 		return 0
 	}
+	return sumValues[float64](col.data, &col.nulls) / float64(n)
+}
 
-	var sum float64
-	for _, v := range col.data {
-		sum += v
-	}
+func (col *Int) Median() float64 {
+	lo, hi := middleValues(col.data, &col.nulls)
+	return (float64(lo) + float64(hi)) / 2
+}
 
-	return sum
+func (col *Int) Std() float64 {
+	return stdValues(col.data, &col.nulls)
 }
 
-// Analytical
+func (col *Int) Unique() IColumn {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &Int{Column[int64]{data: data, nulls: nulls}}
+}
 
-func (col *Int) Min() int64 {
-	if len(col.data) == 0 {
-		panic("empty column")
+func (col *Bool) Unique() *Bool {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &Bool{Column[bool]{data: data, nulls: nulls}}
+}
+
+func (col *String) Unique() IColumn {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &String{Column[string]{data: data, nulls: nulls}}
+}
+
+func (col *Time) Unique() IColumn {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &Time{Column[time.Time]{data: data, nulls: nulls}}
+}
+
+func (col *String) Min() string {
+	return minValue(col.data, &col.nulls)
+}
+
+func (col *String) Max() string {
+	return maxValue(col.data, &col.nulls)
+}
+
+func (col *Categorical) Min() string {
+	return col.extreme(-1)
+}
+
+func (col *Categorical) Max() string {
+	return col.extreme(1)
+}
+
+// extreme returns the smallest level in use when sign is -1 and the largest
+// when it is 1, by the same order as SortBy.
+func (col *Categorical) extreme(sign int) string {
+	if len(col.data) == col.nulls.count {
+		panic(ErrEmptyColumn)
 	}
-	min := col.data[0]
-	for _, v := range col.data {
-		if v < min {
+
+	ranks := col.ranks()
+	best := int32(-1)
+	for i, code := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if best < 0 || (ranks[code]-ranks[best])*sign > 0 {
+			best = code
+		}
+	}
+	return col.levels[best]
+}
+
+func (col *Time) Min() time.Time {
+	if len(col.data) == col.nulls.count {
+		panic(ErrEmptyColumn)
+	}
+	var min time.Time
+	found := false
+	for i, v := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if !found || v.Before(min) {
 			min = v
+			found = true
 		}
 	}
 	return min
 }
 
-func (col *Int) Max() int64 {
-	if len(col.data) == 0 {
-		panic("empty column")
+func (col *Time) Max() time.Time {
+	if len(col.data) == col.nulls.count {
+		panic(ErrEmptyColumn)
 	}
-	max := col.data[0]
-	for _, v := range col.data {
-		if v > max {
+	var max time.Time
+	found := false
+	for i, v := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if !found || v.After(max) {
 			max = v
+			found = true
 		}
 	}
 	return max
 }
 
-func (col *Int) Mean() float64 {
-	if len(col.data) == 0 {
-		return 0.0
-	}
+type RankMethod int
 
-	sum := int64(0)
-	for _, v := range col.data {
-		sum += v
-	}
-	return float64(sum) / float64(len(col.data))
-}
+const (
+	RankAverage RankMethod = iota
+	RankMin
+	RankMax
+	RankDense
+	RankOrdinal
+)
 
-func (col *Int) Median() float64 {
-	if len(col.data) == 0 {
-		panic("empty column")
+func rankValues[T cmp.Ordered](data []T, nulls *bitmap, method RankMethod) *Float {
+	order := make([]int, 0, len(data)-nulls.count)
+	for i := range data {
+		if !nulls.isNull(i) {
+			order = append(order, i)
+		}
 	}
 
-	sorted := append([]int64{}, col.data...)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i] < sorted[j]
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(data[a], data[b])
 	})
 
-	n := len(sorted)
-	if n%2 == 0 {
-		return float64(sorted[n/2-1]+sorted[n/2]) / 2
+	out := &Float{Column[float64]{data: make([]float64, len(data))}}
+	for i := range data {
+		if nulls.isNull(i) {
+			out.nulls.set(i, true)
+		}
 	}
 
-	return float64(sorted[n/2])
-}
+	dense := 0
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && data[order[end]] == data[order[start]] {
+			end++
+		}
+		dense++
+
+		for k := start; k < end; k++ {
+			var rank float64
+			switch method {
+			case RankAverage:
+				rank = float64(start+end+1) / 2
+			case RankMin:
+				rank = float64(start + 1)
+			case RankMax:
+				rank = float64(end)
+			case RankDense:
+				rank = float64(dense)
+			case RankOrdinal:
+				rank = float64(k + 1)
+			}
+			out.data[order[k]] = rank
+		}
 
-func (col *Int) Sum() int64 {
-	if len(col.data) == 0 {
-		return 0
+		start = end
 	}
 
-	var sum int64
-	for _, v := range col.data {
-		sum += v
-	}
+	return out
+}
 
-	return sum
+func (col *Int32) Min() int32 {
+	return minValue(col.data, &col.nulls)
 }
 
-// Unique
+func (col *Int32) Max() int32 {
+	return maxValue(col.data, &col.nulls)
+}
 
-func (col *Int) Unique() IColumn {
-	set := make(map[int64]struct{})
-	uniqueData := []int64{}
+// Sum accumulates in an int64 so that it does not overflow where the values
+// themselves fit.
+func (col *Int32) Sum() int64 {
+	return sumValues[int64](col.data, &col.nulls)
+}
 
-	for _, v := range col.data {
-		if _, exists := set[v]; !exists {
-			set[v] = struct{}{}
-			uniqueData = append(uniqueData, v)
+func (col *Int32) Mean() float64 {
+	return float64(col.Sum()) / float64(nonNull(&col.nulls, len(col.data)))
+}
+
+func (col *Int32) Median() float64 {
+	lo, hi := middleValues(col.data, &col.nulls)
+	return (float64(lo) + float64(hi)) / 2
+}
+
+func (col *Int32) Std() float64 {
+	return stdValues(col.data, &col.nulls)
+}
+
+func (col *Int32) Unique() IColumn {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &Int32{Column[int32]{data: data, nulls: nulls}}
+}
+
+func (col *Uint64) Min() uint64 {
+	return minValue(col.data, &col.nulls)
+}
+
+func (col *Uint64) Max() uint64 {
+	return maxValue(col.data, &col.nulls)
+}
+
+// Sum panics with ErrOverflow rather than wrapping around.
+func (col *Uint64) Sum() uint64 {
+	return sumChecked(col.data, &col.nulls)
+}
+
+func (col *Uint64) Mean() float64 {
+	return sumValues[float64](col.data, &col.nulls) / float64(nonNull(&col.nulls, len(col.data)))
+}
+
+func (col *Uint64) Median() float64 {
+	lo, hi := middleValues(col.data, &col.nulls)
+	return (float64(lo) + float64(hi)) / 2
+}
+
+func (col *Uint64) Std() float64 {
+	return stdValues(col.data, &col.nulls)
+}
+
+func (col *Uint64) Unique() IColumn {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &Uint64{Column[uint64]{data: data, nulls: nulls}}
+}
+
+func (col *Float32) Min() float32 {
+	return minValue(col.data, &col.nulls)
+}
+
+func (col *Float32) Max() float32 {
+	return maxValue(col.data, &col.nulls)
+}
+
+// Sum accumulates in a float64 to avoid losing precision over long columns.
+func (col *Float32) Sum() float64 {
+	return sumValues[float64](col.data, &col.nulls)
+}
+
+func (col *Float32) Mean() float64 {
+	return col.Sum() / float64(nonNull(&col.nulls, len(col.data)))
+}
+
+func (col *Float32) Median() float64 {
+	lo, hi := middleValues(col.data, &col.nulls)
+	return (float64(lo) + float64(hi)) / 2
+}
+
+func (col *Float32) Std() float64 {
+	return stdValues(col.data, &col.nulls)
+}
+
+func (col *Float32) Unique() IColumn {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &Float32{Column[float32]{data: data, nulls: nulls}}
+}
+
+func (col *Decimal) Min() Fixed {
+	return col.fixed(minValue(col.data, &col.nulls))
+}
+
+func (col *Decimal) Max() Fixed {
+	return col.fixed(maxValue(col.data, &col.nulls))
+}
+
+// Sum panics with ErrOverflow rather than wrapping around.
+func (col *Decimal) Sum() Fixed {
+	return col.fixed(sumChecked(col.data, &col.nulls))
+}
+
+// Mean and Median round half away from zero to the scale of the column.
+func (col *Decimal) Mean() Fixed {
+	n := nonNull(&col.nulls, len(col.data))
+	return col.fixed(divRound(col.Sum().Units, int64(n)))
+}
+
+func (col *Decimal) Median() Fixed {
+	lo, hi := middleValues(col.data, &col.nulls)
+	return col.fixed(divRound(lo+hi, 2))
+}
+
+func (col *Decimal) Std() float64 {
+	return stdValues(col.data, &col.nulls) / float64(pow10[col.scale])
+}
+
+func (col *Decimal) Unique() IColumn {
+	data, nulls := uniqueValues(col.data, &col.nulls)
+	return &Decimal{Column[int64]{data: data, nulls: nulls}, col.scale}
+}
+
+type number interface {
+	~int32 | ~int64 | ~uint64 | ~float32 | ~float64
+}
+
+func nonNull(nulls *bitmap, n int) int {
+	if n == nulls.count {
+		panic(ErrEmptyColumn)
+	}
+	return n - nulls.count
+}
+
+func minValue[T cmp.Ordered](data []T, nulls *bitmap) T {
+	nonNull(nulls, len(data))
+
+	var min T
+	found := false
+	for i, v := range data {
+		if nulls.isNull(i) {
+			continue
+		}
+		if !found || v < min {
+			min = v
+			found = true
 		}
 	}
-	return &Int{data: uniqueData}
+	return min
 }
 
-func (col *Float) Unique() IColumn {
-	set := make(map[float64]struct{})
-	uniqueData := []float64{}
+func maxValue[T cmp.Ordered](data []T, nulls *bitmap) T {
+	nonNull(nulls, len(data))
 
-	for _, v := range col.data {
-		if _, exists := set[v]; !exists {
-			set[v] = struct{}{}
-			uniqueData = append(uniqueData, v)
+	var max T
+	found := false
+	for i, v := range data {
+		if nulls.isNull(i) {
+			continue
+		}
+		if !found || v > max {
+			max = v
+			found = true
+		}
+	}
+	return max
+}
+
+func sumValues[S, T number](data []T, nulls *bitmap) S {
+	var sum S
+	for i, v := range data {
+		if !nulls.isNull(i) {
+			sum += S(v)
 		}
 	}
+	return sum
+}
 
-	return &Float{data: uniqueData}
+func sumChecked[T int64 | uint64](data []T, nulls *bitmap) T {
+	var sum T
+	for i, v := range data {
+		if nulls.isNull(i) {
+			continue
+		}
+		next := sum + v
+		if (v > 0) != (next > sum) {
+			panic(fmt.Errorf("%w: sum of %T values", ErrOverflow, v))
+		}
+		sum = next
+	}
+	return sum
 }
 
-func (col *Bool) Unique() *Bool {
-	set := map[bool]struct{}{}
-	uniqueData := []bool{}
+// middleValues returns the two middle values of the sorted non-null values,
+// which are the same value when there is an odd number of them.
+func middleValues[T number](data []T, nulls *bitmap) (T, T) {
+	n := nonNull(nulls, len(data))
 
-	for _, v := range col.data {
-		if _, exists := set[v]; !exists {
-			set[v] = struct{}{}
-			uniqueData = append(uniqueData, v)
+	sorted := make([]T, 0, n)
+	for i, v := range data {
+		if !nulls.isNull(i) {
+			sorted = append(sorted, v)
 		}
 	}
+	slices.Sort(sorted)
 
-	return &Bool{data: uniqueData}
+	return sorted[(n-1)/2], sorted[n/2]
 }
 
-func (col *String) Unique() IColumn {
-	set := make(map[string]struct{})
-	uniqueData := []string{}
+func stdValues[T number](data []T, nulls *bitmap) float64 {
+	n := len(data) - nulls.count
+	if n < 2 {
+		return math.NaN()
+	}
 
-	for _, v := range col.data {
-		if _, exists := set[v]; !exists {
-			set[v] = struct{}{}
-			uniqueData = append(uniqueData, v)
+	mean := sumValues[float64](data, nulls) / float64(n)
+	var sum float64
+	for i, v := range data {
+		if !nulls.isNull(i) {
+			d := float64(v) - mean
+			sum += d * d
 		}
 	}
-	return &String{data: uniqueData}
-}
 
-func (col *Time) Unique() IColumn {
-	set := make(map[time.Time]struct{})
-	uniqueData := []time.Time{}
+	return math.Sqrt(sum / float64(n-1))
+}
 
-	for _, v := range col.data {
+func uniqueValues[T comparable](data []T, nulls *bitmap) ([]T, bitmap) {
+	set := map[T]struct{}{}
+	unique := []T{}
+	var uniqueNulls bitmap
+	hasNull := false
+
+	for i, v := range data {
+		if nulls.isNull(i) {
+			if !hasNull {
+				hasNull = true
+				uniqueNulls.set(len(unique), true)
+				var zero T
+				unique = append(unique, zero)
+			}
+			continue
+		}
 		if _, exists := set[v]; !exists {
 			set[v] = struct{}{}
-			uniqueData = append(uniqueData, v)
+			unique = append(unique, v)
 		}
 	}
-	return &Time{data: uniqueData}
+
+	return unique, uniqueNulls
 }
@@ -0,0 +1,105 @@
+package dataframe
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AsOfOptions configures JoinAsOf. Tolerance bounds how far back a match may
+// be when it is positive or when ApplyTolerance is set, so ApplyTolerance
+// with a zero Tolerance only joins rows with equal times.
+type AsOfOptions struct {
+	By             []string
+	Tolerance      time.Duration
+	ApplyTolerance bool
+	LeftSuffix     string
+	RightSuffix    string
+}
+
+// JoinAsOf matches each left row with the most recent right row whose time
+// is at or before the left row's time, optionally within the same By keys
+// and no further back than Tolerance. Left rows keep their order.
+func JoinAsOf(left, right *DataFrame, on string, opts AsOfOptions) *DataFrame {
+	ltime, lok := left.Column(on).(*Time)
+	rtime, rok := right.Column(on).(*Time)
+	if !lok || !rok {
+		panic(fmt.Errorf("%w: as-of join requires a Time column", ErrTypeMismatch))
+	}
+
+	if opts.LeftSuffix == "" {
+		opts.LeftSuffix = "_left"
+	}
+	if opts.RightSuffix == "" {
+		opts.RightSuffix = "_right"
+	}
+
+	lby := make([]IColumn, len(opts.By))
+	rby := make([]IColumn, len(opts.By))
+	for i, name := range opts.By {
+		lby[i] = left.Column(name)
+		rby[i] = right.Column(name)
+	}
+
+	lcodes, rcodes := joinCodes(lby, rby, left.rowCount, right.rowCount)
+
+	groups := map[int][]int{}
+	for j, code := range rcodes {
+		if code >= 0 && !rtime.nulls.isNull(j) {
+			groups[code] = append(groups[code], j)
+		}
+	}
+	for _, rows := range groups {
+		sort.SliceStable(rows, func(a, b int) bool {
+			return rtime.data[rows[a]].Before(rtime.data[rows[b]])
+		})
+	}
+
+	rrows := make([]int, left.rowCount)
+	for i, code := range lcodes {
+		rrows[i] = -1
+		if code < 0 || ltime.nulls.isNull(i) {
+			continue
+		}
+
+		t := ltime.data[i]
+		rows := groups[code]
+		k := sort.Search(len(rows), func(k int) bool {
+			return rtime.data[rows[k]].After(t)
+		}) - 1
+		if k < 0 {
+			continue
+		}
+
+		if (opts.Tolerance > 0 || opts.ApplyTolerance) && t.Sub(rtime.data[rows[k]]) > opts.Tolerance {
+			continue
+		}
+		rrows[i] = rows[k]
+	}
+
+	skip := map[string]bool{on: true}
+	for _, name := range opts.By {
+		skip[name] = true
+	}
+
+	out := New()
+
+	for i, name := range left.headers {
+		if _, clash := right.index[name]; clash && !skip[name] {
+			name += opts.LeftSuffix
+		}
+		out.AddColumn(name, left.data[i].Clone())
+	}
+
+	for i, name := range right.headers {
+		if skip[name] {
+			continue
+		}
+		if _, clash := left.index[name]; clash {
+			name += opts.RightSuffix
+		}
+		out.AddColumn(name, right.data[i].Take(rrows))
+	}
+
+	return out
+}
@@ -0,0 +1,65 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"testing"
+	"time"
+)
+
+func TestJoinAsOf(t *testing.T) {
+	at := func(sec int) time.Time {
+		return time.Date(2024, 1, 2, 9, 30, sec, 0, time.UTC)
+	}
+
+	trades := dataframe.New()
+	trades.AddColumn("time", dataframe.NewTime(at(1), at(5), at(5), at(20), at(0)))
+	trades.AddColumn("ticker", dataframe.NewString("AAPL", "AAPL", "MSFT", "AAPL", "MSFT"))
+	trades.AddColumn("price", dataframe.NewFloat(100, 101, 300, 102, 299))
+
+	quotes := dataframe.New()
+	quotes.AddColumn("time", dataframe.NewTime(at(4), at(0), at(5), at(2), at(2)))
+	quotes.AddColumn("ticker", dataframe.NewString("AAPL", "AAPL", "AAPL", "MSFT", "MSFT"))
+	quotes.AddColumn("price", dataframe.NewFloat(100.5, 99.5, 100.9, 298, 298.5))
+
+	res := dataframe.JoinAsOf(trades, quotes, "time", dataframe.AsOfOptions{
+		By: []string{"ticker"},
+	})
+
+	if res.Len() != trades.Len() {
+		t.Fatalf("expected %d rows, got %d", trades.Len(), res.Len())
+	}
+
+	want := []any{99.5, 100.9, 298.5, 100.9, nil}
+	for i, v := range want {
+		if got := res.Column("price_right").Index(i); got != v {
+			t.Errorf("row %d: expected %v, got %v", i, v, got)
+		}
+	}
+	if v := res.Column("price_left").Index(0); v != 100.0 {
+		t.Errorf("expected left price to be kept, got %v", v)
+	}
+
+	res = dataframe.JoinAsOf(trades, quotes, "time", dataframe.AsOfOptions{
+		By:        []string{"ticker"},
+		Tolerance: 5 * time.Second,
+	})
+	if !res.Column("price_right").IsNull(3) || res.Column("price_right").IsNull(1) {
+		t.Error("expected tolerance to drop stale quotes only")
+	}
+
+	res = dataframe.JoinAsOf(trades, quotes, "time", dataframe.AsOfOptions{
+		By:             []string{"ticker"},
+		ApplyTolerance: true,
+	})
+	want = []any{nil, 100.9, nil, nil, nil}
+	for i, v := range want {
+		if got := res.Column("price_right").Index(i); got != v {
+			t.Errorf("exact row %d: expected %v, got %v", i, v, got)
+		}
+	}
+
+	res = dataframe.JoinAsOf(trades, quotes, "time", dataframe.AsOfOptions{})
+	if v := res.Column("price_right").Index(4); v != 99.5 {
+		t.Errorf("expected match across tickers without by, got %v", v)
+	}
+}
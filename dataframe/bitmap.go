@@ -0,0 +1,159 @@
+package dataframe
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// bitmap tracks null rows of a column, a set bit marks a null. An empty bitmap
+// means every row holds a value.
+type bitmap struct {
+	bits  []uint64
+	count int
+}
+
+func (b *bitmap) isNull(i int) bool {
+	w := i >> 6
+	return w < len(b.bits) && b.bits[w]&(1<<(i&63)) != 0
+}
+
+func (b *bitmap) set(i int, null bool) {
+	w := i >> 6
+	mask := uint64(1) << (i & 63)
+
+	if !null {
+		if w < len(b.bits) && b.bits[w]&mask != 0 {
+			b.bits[w] &^= mask
+			b.count--
+		}
+		return
+	}
+
+	if w >= len(b.bits) {
+		b.bits = append(b.bits, make([]uint64, w-len(b.bits)+1)...)
+	}
+	if b.bits[w]&mask == 0 {
+		b.bits[w] |= mask
+		b.count++
+	}
+}
+
+func (b *bitmap) setRange(from, to int) {
+	for i := from; i < to; i++ {
+		b.set(i, true)
+	}
+}
+
+func (b *bitmap) deleteRow(index, length int) {
+	if b.count == 0 {
+		return
+	}
+
+	for i := index; i < length-1; i++ {
+		b.set(i, b.isNull(i+1))
+	}
+	b.set(length-1, false)
+}
+
+func (b *bitmap) clone() bitmap {
+	if b.count == 0 {
+		return bitmap{}
+	}
+
+	return bitmap{
+		bits:  append([]uint64{}, b.bits...),
+		count: b.count,
+	}
+}
+
+func sortValues[T any](data []T, nulls *bitmap, less func(a, b T) bool) {
+	if nulls.count == 0 {
+		sort.Slice(data, func(i, j int) bool {
+			return less(data[i], data[j])
+		})
+		return
+	}
+
+	values := make([]T, 0, len(data)-nulls.count)
+	for i, v := range data {
+		if !nulls.isNull(i) {
+			values = append(values, v)
+		}
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return less(values[i], values[j])
+	})
+
+	var zero T
+	for i := range data {
+		if i < len(values) {
+			data[i] = values[i]
+		} else {
+			data[i] = zero
+		}
+	}
+
+	*nulls = bitmap{}
+	nulls.setRange(len(values), len(data))
+}
+
+func takeValues[T any](data []T, nulls *bitmap, indices []int) ([]T, bitmap) {
+	out := make([]T, len(indices))
+	var outNulls bitmap
+
+	for i, idx := range indices {
+		if idx < 0 || nulls.isNull(idx) {
+			outNulls.set(i, true)
+			continue
+		}
+		out[i] = data[idx]
+	}
+
+	return out, outNulls
+}
+
+// mapValues applies f to every non-null value, keeping the nulls in place.
+func mapValues[T, U any](data []T, nulls *bitmap, f func(T) U) ([]U, bitmap) {
+	out := make([]U, len(data))
+	for i, v := range data {
+		if !nulls.isNull(i) {
+			out[i] = f(v)
+		}
+	}
+
+	return out, nulls.clone()
+}
+
+// takeNulls gathers the null bits at indices, where negative indices are null.
+func takeNulls(nulls *bitmap, indices []int) bitmap {
+	var out bitmap
+	for i, idx := range indices {
+		if idx < 0 || nulls.isNull(idx) {
+			out.set(i, true)
+		}
+	}
+	return out
+}
+
+// unionNulls marks a row null when it is null in a or b.
+func unionNulls(a, b *bitmap) bitmap {
+	if a.count == 0 {
+		return b.clone()
+	}
+	if b.count == 0 {
+		return a.clone()
+	}
+
+	out := bitmap{bits: make([]uint64, max(len(a.bits), len(b.bits)))}
+	for w := range out.bits {
+		if w < len(a.bits) {
+			out.bits[w] |= a.bits[w]
+		}
+		if w < len(b.bits) {
+			out.bits[w] |= b.bits[w]
+		}
+		out.count += bits.OnesCount64(out.bits[w])
+	}
+	return out
+}
@@ -0,0 +1,297 @@
+package dataframe
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// Categorical stores strings as codes into a dictionary of levels, which
+// keeps columns with few distinct values small and cheap to group or join
+// on. Levels are numbered in order of first appearance. Once Reorder fixes
+// their order, sorting and Min/Max follow it instead of comparing strings.
+type Categorical struct {
+	Column[int32]
+	*dictionary
+	ordered bool
+}
+
+// dictionary holds the levels of a Categorical. Columns taken, cloned or
+// created from one another share it, and a column copies it before adding
+// a level once it is shared, so taking rows for every group of a GroupBy
+// does not copy the levels each time.
+type dictionary struct {
+	levels []string
+	lookup map[string]int32
+	shared atomic.Bool
+}
+
+func NewCategorical(data ...string) *Categorical {
+	col := &Categorical{Column: Column[int32]{data: make([]int32, len(data))}, dictionary: &dictionary{}}
+	for i, v := range data {
+		col.data[i] = col.code(v)
+	}
+	return col
+}
+
+func (col *String) Categorical() *Categorical {
+	out := &Categorical{
+		Column:     Column[int32]{data: make([]int32, len(col.data)), nulls: col.nulls.clone()},
+		dictionary: &dictionary{},
+	}
+	for i, v := range col.data {
+		if !col.nulls.isNull(i) {
+			out.data[i] = out.code(v)
+		}
+	}
+	return out
+}
+
+func (col *Categorical) Strings() *String {
+	data, nulls := mapValues(col.data, &col.nulls, func(code int32) string {
+		return col.levels[code]
+	})
+	return &String{Column[string]{data: data, nulls: nulls}}
+}
+
+func (col *Categorical) New() IColumn {
+	return col.withCodes(Column[int32]{})
+}
+
+func (col *Categorical) Levels() []string {
+	return slices.Clone(col.levels)
+}
+
+func (col *Categorical) Codes() []int32 {
+	return slices.Clone(col.data)
+}
+
+func (col *Categorical) Ordered() bool {
+	return col.ordered
+}
+
+func (col *Categorical) Index(idx int) any {
+	if col.nulls.isNull(idx) {
+		return nil
+	}
+	return col.levels[col.data[idx]]
+}
+
+func (col *Categorical) Clone() IColumn {
+	return col.withCodes(col.clone())
+}
+
+// Set stores a string, adding it as a new level if needed.
+func (col *Categorical) Set(index int, value any) {
+	if value == nil {
+		col.Column.Set(index, nil)
+		return
+	}
+
+	v, ok := value.(string)
+	if !ok {
+		panic(fmt.Errorf("%w: cannot store %T in Categorical", ErrTypeMismatch, value))
+	}
+	col.Column.Set(index, col.code(v))
+}
+
+func (col *Categorical) Take(indices []int) IColumn {
+	return col.withCodes(col.take(indices))
+}
+
+func (col *Categorical) Append(value string) {
+	col.Column.Append(col.code(value))
+}
+
+func (col *Categorical) Head() (string, bool) {
+	code, ok := col.Column.Head()
+	if !ok {
+		return "", false
+	}
+	return col.levels[code], true
+}
+
+func (col *Categorical) Last() (string, bool) {
+	code, ok := col.Column.Last()
+	if !ok {
+		return "", false
+	}
+	return col.levels[code], true
+}
+
+func (col *Categorical) accepts(value any) bool {
+	_, ok := value.(string)
+	return ok
+}
+
+func (col *Categorical) SortBy(asc bool) {
+	ranks := col.ranks()
+	sortValues(col.data, &col.nulls, func(a, b int32) bool {
+		if asc {
+			return ranks[a] < ranks[b]
+		}
+		return ranks[a] > ranks[b]
+	})
+}
+
+// Reorder sets the order of the levels and marks the column as ordered. It
+// must name every existing level and may add unused ones.
+func (col *Categorical) Reorder(levels ...string) {
+	lookup := make(map[string]int32, len(levels))
+	for i, level := range levels {
+		if _, ok := lookup[level]; ok {
+			panic(fmt.Errorf("%w: duplicate level %q", ErrInvalidArgument, level))
+		}
+		lookup[level] = int32(i)
+	}
+
+	mapping := make([]int32, len(col.levels))
+	for i, level := range col.levels {
+		code, ok := lookup[level]
+		if !ok {
+			panic(fmt.Errorf("%w: missing level %q", ErrInvalidArgument, level))
+		}
+		mapping[i] = code
+	}
+
+	col.remap(mapping, slices.Clone(levels))
+	col.ordered = true
+}
+
+// RenameLevels renames levels in place. Renaming a level to the name of
+// another merges the two, keeping the position of the first.
+func (col *Categorical) RenameLevels(names map[string]string) {
+	levels := []string{}
+	lookup := map[string]int32{}
+	mapping := make([]int32, len(col.levels))
+
+	for i, level := range col.levels {
+		if name, ok := names[level]; ok {
+			level = name
+		}
+
+		code, ok := lookup[level]
+		if !ok {
+			code = int32(len(levels))
+			lookup[level] = code
+			levels = append(levels, level)
+		}
+		mapping[i] = code
+	}
+
+	col.remap(mapping, levels)
+}
+
+func (col *Categorical) MergeLevels(into string, levels ...string) {
+	names := make(map[string]string, len(levels))
+	for _, level := range levels {
+		names[level] = into
+	}
+	col.RenameLevels(names)
+}
+
+func (col *Categorical) code(value string) int32 {
+	if col.dictionary == nil {
+		col.dictionary = &dictionary{}
+	}
+
+	code, ok := col.lookup[value]
+	if !ok {
+		if col.shared.Load() {
+			col.dictionary = &dictionary{levels: slices.Clone(col.levels), lookup: maps.Clone(col.lookup)}
+		}
+		if col.lookup == nil {
+			col.lookup = map[string]int32{}
+		}
+		code = int32(len(col.levels))
+		col.lookup[value] = code
+		col.levels = append(col.levels, value)
+	}
+	return code
+}
+
+func (col *Categorical) remap(mapping []int32, levels []string) {
+	for i, code := range col.data {
+		if !col.nulls.isNull(i) {
+			col.data[i] = mapping[code]
+		}
+	}
+
+	lookup := make(map[string]int32, len(levels))
+	for i, level := range levels {
+		lookup[level] = int32(i)
+	}
+	col.dictionary = &dictionary{levels: levels, lookup: lookup}
+}
+
+func (col *Categorical) withCodes(codes Column[int32]) *Categorical {
+	if col.dictionary == nil {
+		col.dictionary = &dictionary{}
+	}
+	col.shared.Store(true)
+	return &Categorical{Column: codes, dictionary: col.dictionary, ordered: col.ordered}
+}
+
+// ranks gives the sort position of every level: the level order when the
+// column is ordered and lexical order otherwise.
+func (col *Categorical) ranks() []int {
+	ranks := make([]int, len(col.levels))
+	if col.ordered {
+		for i := range ranks {
+			ranks[i] = i
+		}
+		return ranks
+	}
+
+	order := make([]int, len(col.levels))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		return cmp.Compare(col.levels[a], col.levels[b])
+	})
+	for rank, code := range order {
+		ranks[code] = rank
+	}
+	return ranks
+}
+
+// recode returns the codes of other in terms of the levels of col, numbering
+// levels col does not have after its own.
+func (col *Categorical) recode(other *Categorical) []int32 {
+	next := int32(len(col.levels))
+	mapping := make([]int32, len(other.levels))
+	for i, level := range other.levels {
+		code, ok := col.lookup[level]
+		if !ok {
+			code = next
+			next++
+		}
+		mapping[i] = code
+	}
+
+	out := make([]int32, len(other.data))
+	for i, code := range other.data {
+		if !other.nulls.isNull(i) {
+			out[i] = mapping[code]
+		}
+	}
+	return out
+}
+
+// compare orders the value at row i against a level, by level position when
+// the column is ordered and as strings otherwise.
+func (col *Categorical) compare(i int, value string) int {
+	if !col.ordered {
+		return strings.Compare(col.levels[col.data[i]], value)
+	}
+
+	code, ok := col.lookup[value]
+	if !ok {
+		panic(fmt.Errorf("%w: unknown level %q", ErrInvalidArgument, value))
+	}
+	return cmp.Compare(col.data[i], code)
+}
@@ -0,0 +1,85 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"slices"
+	"testing"
+)
+
+func TestCategorical(t *testing.T) {
+	str := dataframe.NewString("info", "warn", "info", "error")
+	str.AppendNull()
+
+	col := str.Categorical()
+	if levels := col.Levels(); !slices.Equal(levels, []string{"info", "warn", "error"}) {
+		t.Errorf("unexpected levels %v", levels)
+	}
+	assertColumn(t, "strings", col.Strings(), []any{"info", "warn", "info", "error", nil})
+
+	col.Set(4, "debug")
+	col.MergeLevels("problem", "warn", "error")
+	col.RenameLevels(map[string]string{"info": "ok"})
+	assertColumn(t, "merged", col, []any{"ok", "problem", "ok", "problem", "debug"})
+	if levels := col.Levels(); !slices.Equal(levels, []string{"ok", "problem", "debug"}) {
+		t.Errorf("unexpected levels after merge %v", levels)
+	}
+
+	if col.Min() != "debug" || col.Max() != "problem" {
+		t.Errorf("unexpected lexical min/max %s/%s", col.Min(), col.Max())
+	}
+
+	col.Reorder("debug", "ok", "problem", "fatal")
+	if !col.Ordered() || col.Max() != "problem" {
+		t.Errorf("expected ordered column with max problem, got %s", col.Max())
+	}
+
+	df := dataframe.New()
+	df.AddColumn("level", col)
+	df.AddColumn("n", dataframe.NewInt(1, 2, 3, 4, 5))
+	df.Sort(dataframe.SortKey{Column: "level", Descending: true})
+	assertColumn(t, "sorted", df.Column("level"), []any{"problem", "problem", "ok", "ok", "debug"})
+
+	if n := df.Filtered(&dataframe.GTE{Column: "level", Value: "ok"}).Len(); n != 4 {
+		t.Errorf("expected 4 rows at or above ok, got %d", n)
+	}
+	if n := df.Filtered(&dataframe.EQ{Column: "level", Value: "debug"}).Len(); n != 1 {
+		t.Errorf("expected 1 debug row, got %d", n)
+	}
+}
+
+func TestCategoricalKeys(t *testing.T) {
+	events := dataframe.New()
+	events.AddColumn("host", dataframe.NewCategorical("a", "b", "a", "c", "a"))
+	events.AddColumn("bytes", dataframe.NewInt(1, 2, 3, 4, 5))
+
+	out := events.GroupBy("host").Agg(dataframe.Aggregation{Column: "bytes", Func: dataframe.Sum})
+	if _, ok := out.Column("host").(*dataframe.Categorical); !ok {
+		t.Errorf("expected Categorical key column, got %T", out.Column("host"))
+	}
+	assertColumn(t, "host", out.Column("host"), []any{"a", "b", "c"})
+	assertColumn(t, "bytes", out.Column("bytes"), []any{int64(9), int64(2), int64(4)})
+
+	hosts := dataframe.New()
+	hosts.AddColumn("host", dataframe.NewCategorical("c", "d", "a"))
+	hosts.AddColumn("rack", dataframe.NewInt(3, 4, 1))
+
+	joined := dataframe.Join(events, hosts, []string{"host"}, dataframe.InnerJoin)
+	assertColumn(t, "joined", joined.Column("host"), []any{"a", "a", "c", "a"})
+	assertColumn(t, "rack", joined.Column("rack"), []any{int64(1), int64(1), int64(3), int64(1)})
+}
+
+func TestCategoricalSharedLevels(t *testing.T) {
+	col := dataframe.NewCategorical("a", "b", "a")
+	taken := col.Take([]int{2, 0}).(*dataframe.Categorical)
+
+	taken.Set(1, "c")
+	col.Set(0, "d")
+	if levels := col.Levels(); !slices.Equal(levels, []string{"a", "b", "d"}) {
+		t.Errorf("unexpected levels %v", levels)
+	}
+	if levels := taken.Levels(); !slices.Equal(levels, []string{"a", "b", "c"}) {
+		t.Errorf("unexpected levels of the taken column %v", levels)
+	}
+	assertColumn(t, "taken", taken, []any{"a", "c"})
+	assertColumn(t, "col", col, []any{"d", "b", "a"})
+}
@@ -0,0 +1,239 @@
+package dataframe
+
+import (
+	"fmt"
+	"slices"
+)
+
+type IColumn interface {
+	Len() int
+	Extend(int)
+	Index(int) any
+	New() IColumn
+	Clone() IColumn
+	DeleteRow(index int)
+	Set(index int, value any)
+	Take(indices []int) IColumn
+	IsNull(index int) bool
+	NullCount() int
+}
+
+// Column stores values of any element type with a null bitmap and
+// implements IColumn on its own, so new element types need no changes
+// elsewhere. The built-in column types embed it and add their arithmetic,
+// sorting and string operations.
+type Column[T any] struct {
+	data  []T
+	nulls bitmap
+}
+
+func NewColumn[T any](data ...T) *Column[T] {
+	return &Column[T]{
+		data: data,
+	}
+}
+
+// ColumnAs returns the named column with its values typed as T. It accepts
+// both plain columns and the built-in types, so ColumnAs[int64] works on an
+// Int column.
+func ColumnAs[T any](df *DataFrame, name string) *Column[T] {
+	col, ok := df.Column(name).(interface{ base() *Column[T] })
+	if !ok {
+		var zero T
+		panic(fmt.Errorf("%w: column %q is %T, not a column of %T", ErrTypeMismatch, name, df.Column(name), zero))
+	}
+	return col.base()
+}
+
+func (col *Column[T]) base() *Column[T] {
+	return col
+}
+
+func (col *Column[T]) New() IColumn {
+	return &Column[T]{}
+}
+
+func (col *Column[T]) Len() int {
+	return len(col.data)
+}
+
+// Get returns the value at idx, or the zero value if it is null.
+func (col *Column[T]) Get(idx int) T {
+	return col.data[idx]
+}
+
+// Values returns a copy of the values, with the zero value at nulls.
+func (col *Column[T]) Values() []T {
+	return slices.Clone(col.data)
+}
+
+func (col *Column[T]) Data() []T {
+	return col.Values()
+}
+
+func (col *Column[T]) Extend(length int) {
+	diff := length - len(col.data)
+	if diff > 0 {
+		col.nulls.setRange(len(col.data), length)
+		col.data = append(col.data, make([]T, diff)...)
+	}
+}
+
+func (col *Column[T]) Index(idx int) any {
+	if col.nulls.isNull(idx) {
+		return nil
+	}
+	return col.data[idx]
+}
+
+func (col *Column[T]) IsNull(idx int) bool {
+	return col.nulls.isNull(idx)
+}
+
+func (col *Column[T]) NullCount() int {
+	return col.nulls.count
+}
+
+func (col *Column[T]) Clone() IColumn {
+	c := col.clone()
+	return &c
+}
+
+func (col *Column[T]) DeleteRow(index int) {
+	col.nulls.deleteRow(index, len(col.data))
+	col.data = append(col.data[:index], col.data[index+1:]...)
+}
+
+func (col *Column[T]) Set(index int, value any) {
+	if value == nil {
+		var zero T
+		col.data[index] = zero
+		col.nulls.set(index, true)
+		return
+	}
+
+	v, ok := value.(T)
+	if !ok {
+		panic(fmt.Errorf("%w: cannot store %T in column of %T", ErrTypeMismatch, value, v))
+	}
+	col.data[index] = v
+	col.nulls.set(index, false)
+}
+
+func (col *Column[T]) Take(indices []int) IColumn {
+	c := col.take(indices)
+	return &c
+}
+
+func (col *Column[T]) Append(value T) {
+	col.data = append(col.data, value)
+}
+
+func (col *Column[T]) AppendNull() {
+	var zero T
+	col.nulls.set(len(col.data), true)
+	col.data = append(col.data, zero)
+}
+
+func (col *Column[T]) Head() (T, bool) {
+	if len(col.data) == 0 || col.nulls.isNull(0) {
+		var zero T
+		return zero, false
+	}
+	return col.data[0], true
+}
+
+func (col *Column[T]) Tail() []T {
+	if len(col.data) <= 1 {
+		return nil
+	}
+	return col.data[1:]
+}
+
+func (col *Column[T]) Last() (T, bool) {
+	if len(col.data) == 0 || col.nulls.isNull(len(col.data)-1) {
+		var zero T
+		return zero, false
+	}
+	return col.data[len(col.data)-1], true
+}
+
+func (col *Column[T]) Slice(start, end int) []T {
+	return slices.Clone(col.data[start:end])
+}
+
+func (col *Column[T]) clone() Column[T] {
+	return Column[T]{data: slices.Clone(col.data), nulls: col.nulls.clone()}
+}
+
+func (col *Column[T]) take(indices []int) Column[T] {
+	data, nulls := takeValues(col.data, &col.nulls, indices)
+	return Column[T]{data: data, nulls: nulls}
+}
+
+func (col *Column[T]) accepts(value any) bool {
+	_, ok := value.(T)
+	return ok
+}
+
+// shiftValues moves values down by n rows, or up for negative n, filling the
+// rows left behind with nulls.
+func shiftValues[T any](col *Column[T], n int) Column[T] {
+	out := Column[T]{data: make([]T, len(col.data))}
+	for i := range col.data {
+		j := i - n
+		if j < 0 || j >= len(col.data) || col.nulls.isNull(j) {
+			out.nulls.set(i, true)
+			continue
+		}
+		out.data[i] = col.data[j]
+	}
+	return out
+}
+
+func diffValues[T int64 | float64](col *Column[T], n int) Column[T] {
+	out := Column[T]{data: make([]T, len(col.data))}
+	for i := range col.data {
+		j := i - n
+		if j < 0 || j >= len(col.data) || col.nulls.isNull(i) || col.nulls.isNull(j) {
+			out.nulls.set(i, true)
+			continue
+		}
+		out.data[i] = col.data[i] - col.data[j]
+	}
+	return out
+}
+
+func pctChange[T int64 | float64](col *Column[T], n int) Column[float64] {
+	out := Column[float64]{data: make([]float64, len(col.data))}
+	for i := range col.data {
+		j := i - n
+		if j < 0 || j >= len(col.data) || col.nulls.isNull(i) || col.nulls.isNull(j) || col.data[j] == 0 {
+			out.nulls.set(i, true)
+			continue
+		}
+		out.data[i] = float64(col.data[i])/float64(col.data[j]) - 1
+	}
+	return out
+}
+
+// scanValues returns the running result of f over the non-null values,
+// starting from the first of them. Nulls stay null and are skipped.
+func scanValues[T any](col *Column[T], f func(acc, v T) T) Column[T] {
+	out := Column[T]{data: make([]T, len(col.data))}
+	var acc T
+	started := false
+	for i, v := range col.data {
+		if col.nulls.isNull(i) {
+			out.nulls.set(i, true)
+			continue
+		}
+		if started {
+			acc = f(acc, v)
+		} else {
+			acc, started = v, true
+		}
+		out.data[i] = acc
+	}
+	return out
+}
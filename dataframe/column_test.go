@@ -0,0 +1,80 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"slices"
+	"testing"
+)
+
+type ipv4 [4]byte
+
+func TestColumnCustomType(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("ip", dataframe.NewColumn(ipv4{10, 0, 0, 1}, ipv4{10, 0, 0, 2}))
+	df.AddColumn("hits", dataframe.NewInt(3, 4))
+	df.AppendRow(ipv4{10, 0, 0, 1}, 5)
+	df.AppendRow(nil, 6)
+
+	ips := dataframe.ColumnAs[ipv4](df, "ip")
+	if ips.Get(2) != (ipv4{10, 0, 0, 1}) || !ips.IsNull(3) {
+		t.Errorf("unexpected values %v", ips.Values())
+	}
+
+	if n := df.Filtered(&dataframe.EQ{Column: "ip", Value: ipv4{10, 0, 0, 1}}).Len(); n != 2 {
+		t.Errorf("expected 2 rows for 10.0.0.1, got %d", n)
+	}
+	if row := df.Row(1); row[0] != (ipv4{10, 0, 0, 2}) {
+		t.Errorf("unexpected row %v", row)
+	}
+
+	out := df.GroupBy("ip").Agg(dataframe.Aggregation{Column: "hits", Func: dataframe.Sum})
+	assertColumn(t, "hits", out.Column("hits"), []any{int64(8), int64(4), int64(6)})
+
+	df.Computed(dataframe.Computed[ipv4]{
+		Name: "subnet",
+		Func: func(row map[string]any) ipv4 {
+			ip, _ := row["ip"].(ipv4)
+			return ipv4{ip[0], ip[1], ip[2], 0}
+		},
+	})
+	if _, ok := df.Column("subnet").(*dataframe.Column[ipv4]); !ok {
+		t.Errorf("expected a generic column, got %T", df.Column("subnet"))
+	}
+
+	type record struct {
+		IP   ipv4  `df:"ip"`
+		Hits int64 `df:"hits"`
+	}
+	records, err := dataframe.ToStructs[record](df)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if records[2].IP != (ipv4{10, 0, 0, 1}) || records[2].Hits != 5 {
+		t.Errorf("unexpected record %+v", records[2])
+	}
+}
+
+func TestColumnAs(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("x", dataframe.NewInt(1, 2, 3))
+	df.Computed(dataframe.Computed[float64]{
+		Name: "half",
+		Func: func(row map[string]any) float64 { return float64(row["x"].(int64)) / 2 },
+	})
+
+	if _, ok := df.Column("half").(*dataframe.Float); !ok {
+		t.Errorf("expected Float column, got %T", df.Column("half"))
+	}
+
+	x := dataframe.ColumnAs[int64](df, "x")
+	if !slices.Equal(x.Values(), []int64{1, 2, 3}) || x.Get(1) != 2 {
+		t.Errorf("unexpected values %v", x.Values())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for mismatched element type")
+		}
+	}()
+	dataframe.ColumnAs[string](df, "x")
+}
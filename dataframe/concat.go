@@ -0,0 +1,100 @@
+package dataframe
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Concat stacks frames row-wise, aligning columns by name. Columns missing
+// from a frame are filled with nulls and Int columns are widened to Float
+// when another frame holds Float values under the same name, as are Decimal
+// columns to the largest scale among the frames.
+func Concat(frames ...*DataFrame) (*DataFrame, error) {
+	names := []string{}
+	types := map[string]IColumn{}
+
+	for _, df := range frames {
+		for i, name := range df.headers {
+			col := df.data[i]
+
+			prev, ok := types[name]
+			if !ok {
+				names = append(names, name)
+				types[name] = col
+				continue
+			}
+
+			if reflect.TypeOf(prev) == reflect.TypeOf(col) {
+				// widen Decimal columns to the largest scale so no digit is lost
+				if d, ok := col.(*Decimal); ok && d.scale > prev.(*Decimal).scale {
+					types[name] = col
+				}
+				continue
+			}
+
+			_, prevInt := prev.(*Int)
+			_, prevFloat := prev.(*Float)
+			_, colInt := col.(*Int)
+			_, colFloat := col.(*Float)
+
+			switch {
+			case prevInt && colFloat:
+				types[name] = col
+			case prevFloat && colInt:
+			default:
+				return nil, fmt.Errorf("column %q: %w: cannot concatenate %T and %T", name, ErrTypeMismatch, prev, col)
+			}
+		}
+	}
+
+	out := New()
+
+	for _, name := range names {
+		col := types[name].New()
+
+		for _, df := range frames {
+			idx, ok := df.index[name]
+			if !ok {
+				col.Extend(col.Len() + df.rowCount)
+				continue
+			}
+
+			// extend a row at a time so List columns append instead of
+			// rebuilding their elements
+			src := df.data[idx]
+			for i := 0; i < df.rowCount; i++ {
+				n := col.Len()
+				col.Extend(n + 1)
+				if v := src.Index(i); v != nil {
+					col.Set(n, coerce(col, v))
+				}
+			}
+		}
+
+		out.AddColumn(name, col)
+	}
+
+	return out, nil
+}
+
+func ConcatColumns(frames ...*DataFrame) (*DataFrame, error) {
+	out := New()
+
+	for k, df := range frames {
+		if k > 0 && df.rowCount != out.rowCount {
+			return nil, fmt.Errorf(
+				"frame %d: %w: expected %d rows, got %d",
+				k, ErrLengthMismatch, out.rowCount, df.rowCount,
+			)
+		}
+
+		for i, name := range df.headers {
+			if _, ok := out.index[name]; ok {
+				return nil, fmt.Errorf("duplicate column %q", name)
+			}
+			out.AddColumn(name, df.data[i].Clone())
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,83 @@
+package dataframe_test
+
+import (
+	"fmt"
+	"go-numeric/dataframe"
+	"strings"
+	"testing"
+)
+
+func TestConcat(t *testing.T) {
+	a := dataframe.New()
+	a.AddColumn("id", dataframe.NewInt(1, 2))
+	a.AddColumn("value", dataframe.NewInt(10, 20))
+
+	b := dataframe.New()
+	b.AddColumn("value", dataframe.NewFloat(2.5))
+	b.AddColumn("note", dataframe.NewString("late"))
+
+	res, err := dataframe.Concat(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if h := strings.Join(res.Headers(), ","); h != "id,value,note" {
+		t.Fatalf("unexpected headers %s", h)
+	}
+	if res.Len() != 3 {
+		t.Fatalf("expected 3 rows, got %d", res.Len())
+	}
+
+	value, ok := res.Column("value").(*dataframe.Float)
+	if !ok {
+		t.Fatalf("expected value to be widened to Float, got %T", res.Column("value"))
+	}
+	if v := value.Sum(); v != 32.5 {
+		t.Errorf("unexpected sum %v", v)
+	}
+	if !res.Column("id").IsNull(2) || res.Column("note").NullCount() != 2 {
+		t.Error("expected missing columns to be null")
+	}
+
+	c := dataframe.New()
+	c.AddColumn("id", dataframe.NewString("x"))
+	if _, err := dataframe.Concat(a, c); err == nil {
+		t.Error("expected error for incompatible column types")
+	}
+
+	whole, cents := dataframe.New(), dataframe.New()
+	whole.AddColumn("price", dataframe.NewDecimal(0, 1))
+	cents.AddColumn("price", dataframe.NewDecimal(2, 125))
+	prices, err := dataframe.Concat(whole, cents)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(prices.Column("price").(*dataframe.Decimal).Data()); got != "[1.00 1.25]" {
+		t.Errorf("expected prices widened to two digits, got %s", got)
+	}
+}
+
+func TestConcatColumns(t *testing.T) {
+	a := dataframe.New()
+	a.AddColumn("id", dataframe.NewInt(1, 2))
+
+	b := dataframe.New()
+	b.AddColumn("name", dataframe.NewString("x", "y"))
+
+	res, err := dataframe.ConcatColumns(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.NumColumns() != 2 || res.Len() != 2 {
+		t.Errorf("unexpected shape %dx%d", res.NumColumns(), res.Len())
+	}
+
+	c := dataframe.New()
+	c.AddColumn("other", dataframe.NewInt(1))
+	if _, err := dataframe.ConcatColumns(a, c); err == nil {
+		t.Error("expected error for differing row counts")
+	}
+	if _, err := dataframe.ConcatColumns(a, a); err == nil {
+		t.Error("expected error for duplicate columns")
+	}
+}
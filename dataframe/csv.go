@@ -0,0 +1,351 @@
+package dataframe
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var defaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// CSVOptions configures LoadCSVOptions. Headers names the columns, replacing
+// the header row of the file unless NoHeader says there is none. Empty fields
+// are null unless KeepEmpty is set, in which case they load as empty strings
+// in String and Categorical columns and stay null elsewhere. NullValues lists
+// further fields read as null.
+type CSVOptions struct {
+	Delimiter   rune
+	SkipRows    int
+	NoHeader    bool
+	Headers     []string
+	Schema      map[string]IColumn
+	TimeLayouts []string
+	NullValues  []string
+	KeepEmpty   bool
+}
+
+func LoadCSV(rdr io.Reader) (*DataFrame, error) {
+	return LoadCSVOptions(rdr, CSVOptions{})
+}
+
+func LoadCSVOptions(rdr io.Reader, opts CSVOptions) (*DataFrame, error) {
+	r := csv.NewReader(rdr)
+	r.FieldsPerRecord = -1
+	if opts.Delimiter != 0 {
+		r.Comma = opts.Delimiter
+	}
+
+	layouts := opts.TimeLayouts
+	if len(layouts) == 0 {
+		layouts = defaultTimeLayouts
+	}
+
+	nulls := map[string]struct{}{}
+	if !opts.KeepEmpty {
+		nulls[""] = struct{}{}
+	}
+	for _, v := range opts.NullValues {
+		nulls[v] = struct{}{}
+	}
+
+	for range opts.SkipRows {
+		if _, err := r.Read(); err != nil {
+			if err == io.EOF {
+				return New(), nil
+			}
+			return nil, err
+		}
+	}
+
+	records := [][]string{}
+	lines := []int{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+
+	if len(records) == 0 && opts.NoHeader && len(opts.Headers) == 0 {
+		return New(), nil
+	}
+
+	if !opts.NoHeader {
+		if len(records) == 0 {
+			return New(), nil
+		}
+		if len(opts.Headers) > 0 && len(opts.Headers) != len(records[0]) {
+			return nil, fmt.Errorf(
+				"expected %d headers, got %d", len(records[0]), len(opts.Headers),
+			)
+		}
+	}
+
+	var headers []string
+	switch {
+	case len(opts.Headers) > 0:
+		headers = opts.Headers
+		if !opts.NoHeader {
+			records, lines = records[1:], lines[1:]
+		}
+	case !opts.NoHeader:
+		headers, records, lines = records[0], records[1:], lines[1:]
+	default:
+		for j := range records[0] {
+			headers = append(headers, strconv.Itoa(j))
+		}
+	}
+
+	seen := make(map[string]struct{}, len(headers))
+	for _, h := range headers {
+		if _, ok := seen[h]; ok {
+			return nil, fmt.Errorf("duplicate column %q", h)
+		}
+		seen[h] = struct{}{}
+	}
+
+	for name := range opts.Schema {
+		if _, ok := seen[name]; !ok {
+			return nil, fmt.Errorf("schema column %q not found", name)
+		}
+	}
+
+	for i, record := range records {
+		if len(record) != len(headers) {
+			return nil, fmt.Errorf(
+				"line %d: expected %d fields, got %d",
+				lines[i], len(headers), len(record),
+			)
+		}
+	}
+
+	df := New()
+	values := make([]string, len(records))
+	null := make([]bool, len(records))
+
+	for j, name := range headers {
+		for i, record := range records {
+			values[i] = record[j]
+			if _, null[i] = nulls[values[i]]; null[i] {
+				values[i] = ""
+			}
+		}
+
+		var col IColumn
+		if proto, ok := opts.Schema[name]; ok {
+			col = proto.New()
+		} else {
+			col = inferColumn(values, layouts)
+		}
+
+		if err := parseColumn(col, values, null, layouts); err != nil {
+			return nil, fmt.Errorf("column %q: %w", name, err)
+		}
+
+		df.AddColumn(name, col)
+	}
+
+	return df, nil
+}
+
+func (df *DataFrame) ToCSV(wr io.Writer) error {
+	w := csv.NewWriter(wr)
+
+	if err := w.Write(df.headers); err != nil {
+		return err
+	}
+
+	record := make([]string, len(df.data))
+	for i := 0; i < df.rowCount; i++ {
+		for j, col := range df.data {
+			switch col.(type) {
+			case *List, *Struct:
+				// nested values are written as JSON text
+				b, err := marshalCell(col, i)
+				if err != nil {
+					return fmt.Errorf("column %q: %w", df.headers[j], err)
+				}
+				record[j] = string(b)
+				if col.IsNull(i) {
+					record[j] = ""
+				}
+			default:
+				record[j] = formatText(col.Index(i))
+			}
+		}
+
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func inferColumn(values []string, layouts []string) IColumn {
+	isInt, isFloat, isBool, isTime := true, true, true, true
+	empty := true
+
+	for _, s := range values {
+		if s == "" {
+			continue
+		}
+		empty = false
+
+		if isInt {
+			_, err := strconv.ParseInt(s, 10, 64)
+			isInt = err == nil
+		}
+		if isFloat {
+			_, err := strconv.ParseFloat(s, 64)
+			isFloat = err == nil
+		}
+		if isBool {
+			_, err := parseBool(s)
+			isBool = err == nil
+		}
+		if isTime {
+			_, err := parseTime(s, layouts)
+			isTime = err == nil
+		}
+
+		if !isInt && !isFloat && !isBool && !isTime {
+			break
+		}
+	}
+
+	switch {
+	case empty:
+		return NewString()
+	case isInt:
+		return NewInt()
+	case isFloat:
+		return NewFloat()
+	case isBool:
+		return NewBool()
+	case isTime:
+		return NewTime()
+	default:
+		return NewString()
+	}
+}
+
+// parseColumn appends values to col. Rows marked null are null, as are empty
+// values in columns other than String and Categorical.
+func parseColumn(col IColumn, values []string, null []bool, layouts []string) error {
+	n := col.Len()
+	col.Extend(n + len(values))
+
+	_, text := col.(*String)
+	if _, ok := col.(*Categorical); ok {
+		text = true
+	}
+
+	for i, s := range values {
+		if null[i] || (s == "" && !text) {
+			continue
+		}
+
+		v, err := parseText(col, s, layouts)
+		if err != nil {
+			return fmt.Errorf("row %d: %w", i+1, err)
+		}
+		col.Set(n+i, v)
+	}
+
+	return nil
+}
+
+// parseText parses s into the Go type stored by col.
+func parseText(col IColumn, s string, layouts []string) (any, error) {
+	switch col.(type) {
+	case *Int:
+		return strconv.ParseInt(s, 10, 64)
+	case *Float:
+		return strconv.ParseFloat(s, 64)
+	case *Bool:
+		return parseBool(s)
+	case *String, *Categorical:
+		return s, nil
+	case *Int32:
+		v, err := strconv.ParseInt(s, 10, 32)
+		return int32(v), err
+	case *Uint64:
+		return strconv.ParseUint(s, 10, 64)
+	case *Float32:
+		v, err := strconv.ParseFloat(s, 32)
+		return float32(v), err
+	case *Decimal:
+		return ParseFixed(s)
+	case *Time:
+		return parseTime(s, layouts)
+	default:
+		return nil, fmt.Errorf("unsupported column type %T", col)
+	}
+}
+
+func parseBool(s string) (bool, error) {
+	switch {
+	case strings.EqualFold(s, "true"):
+		return true, nil
+	case strings.EqualFold(s, "false"):
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid bool %q", s)
+	}
+}
+
+func parseTime(s string, layouts []string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+func formatText(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return formatFloat(v, 64)
+	case float32:
+		return formatFloat(float64(v), 32)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// formatFloat keeps a decimal point on whole numbers so they read back as
+// floats rather than ints.
+func formatFloat(v float64, bitSize int) string {
+	s := strconv.FormatFloat(v, 'g', -1, bitSize)
+	if !strings.ContainsAny(s, ".eEnN") {
+		s += ".0"
+	}
+	return s
+}
@@ -0,0 +1,176 @@
+package dataframe_test
+
+import (
+	"bytes"
+	"go-numeric/dataframe"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadCSV(t *testing.T) {
+	input := `name,count,ratio,active,created
+"Smith, John",1,0.5,true,2024-01-02T03:04:05Z
+"say ""hi""",2,1.0,false,2024-02-03T04:05:06.5Z
+plain,3,2,TRUE,2024-03-04T05:06:07+02:00
+`
+
+	df, err := dataframe.LoadCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if df.Len() != 3 || df.NumColumns() != 5 {
+		t.Fatalf("unexpected shape %dx%d", df.NumColumns(), df.Len())
+	}
+
+	if _, ok := df.Column("name").(*dataframe.String); !ok {
+		t.Errorf("name: expected String, got %T", df.Column("name"))
+	}
+	if _, ok := df.Column("count").(*dataframe.Int); !ok {
+		t.Errorf("count: expected Int, got %T", df.Column("count"))
+	}
+	if _, ok := df.Column("ratio").(*dataframe.Float); !ok {
+		t.Errorf("ratio: expected Float, got %T", df.Column("ratio"))
+	}
+	if _, ok := df.Column("active").(*dataframe.Bool); !ok {
+		t.Errorf("active: expected Bool, got %T", df.Column("active"))
+	}
+	if _, ok := df.Column("created").(*dataframe.Time); !ok {
+		t.Errorf("created: expected Time, got %T", df.Column("created"))
+	}
+
+	if v := df.Column("name").Index(1).(string); v != `say "hi"` {
+		t.Errorf("unexpected quoted value %q", v)
+	}
+
+	var buf bytes.Buffer
+	if err := df.ToCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	df2, err := dataframe.LoadCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < df.Len(); i++ {
+		a, b := df.Row(i), df2.Row(i)
+		for j := range a {
+			if x, ok := a[j].(time.Time); ok {
+				if !x.Equal(b[j].(time.Time)) {
+					t.Errorf("row %d col %d: %v != %v", i, j, a[j], b[j])
+				}
+				continue
+			}
+			if a[j] != b[j] {
+				t.Errorf("row %d col %d: %v != %v", i, j, a[j], b[j])
+			}
+		}
+	}
+}
+
+func TestLoadCSVMalformed(t *testing.T) {
+	inputs := []string{
+		"a,b\n1,2,3\n",
+		"a,a\n1,2\n",
+		"a,b\n\"1,2\n",
+	}
+
+	for _, input := range inputs {
+		if _, err := dataframe.LoadCSV(strings.NewReader(input)); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestLoadCSVOptions(t *testing.T) {
+	input := "exported by vendor\n" +
+		"zip;id;reading;taken\n" +
+		"02134;007;1.5;02/01/2024\n" +
+		"90210;042;NA;03/01/2024\n"
+
+	df, err := dataframe.LoadCSVOptions(strings.NewReader(input), dataframe.CSVOptions{
+		Delimiter: ';',
+		SkipRows:  1,
+		Schema: map[string]dataframe.IColumn{
+			"zip": dataframe.NewString(),
+			"id":  dataframe.NewString(),
+		},
+		TimeLayouts: []string{"02/01/2006"},
+		NullValues:  []string{"NA"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := df.Column("zip").Index(0); v != "02134" {
+		t.Errorf("zip: expected 02134, got %v", v)
+	}
+	if v := df.Column("id").Index(1); v != "042" {
+		t.Errorf("id: expected 042, got %v", v)
+	}
+	if _, ok := df.Column("reading").(*dataframe.Float); !ok {
+		t.Errorf("reading: expected Float, got %T", df.Column("reading"))
+	}
+
+	taken, ok := df.Column("taken").(*dataframe.Time)
+	if !ok {
+		t.Fatalf("taken: expected Time, got %T", df.Column("taken"))
+	}
+	if v := taken.Data()[1]; !v.Equal(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("taken: unexpected value %v", v)
+	}
+}
+
+func TestLoadCSVNoHeader(t *testing.T) {
+	input := "a\t1\nb\t2\n"
+
+	df, err := dataframe.LoadCSVOptions(strings.NewReader(input), dataframe.CSVOptions{
+		Delimiter: '\t',
+		NoHeader:  true,
+		Headers:   []string{"key", "value"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if df.Len() != 2 || df.Column("value").(*dataframe.Int).Sum() != 3 {
+		t.Errorf("unexpected frame %v", df.Headers())
+	}
+
+	_, err = dataframe.LoadCSVOptions(strings.NewReader("1,2\n"), dataframe.CSVOptions{
+		Schema: map[string]dataframe.IColumn{"missing": dataframe.NewInt()},
+	})
+	if err == nil {
+		t.Error("expected error for unknown schema column")
+	}
+}
+
+func TestLoadCSVHeadersAndEmpty(t *testing.T) {
+	input := "a,b,c\nx,,1\n,NA,\n"
+
+	df, err := dataframe.LoadCSVOptions(strings.NewReader(input), dataframe.CSVOptions{
+		Headers:    []string{"name", "note", "n"},
+		NullValues: []string{"NA"},
+		KeepEmpty:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if h := df.Headers(); !slices.Equal(h, []string{"name", "note", "n"}) {
+		t.Errorf("expected the header row to be replaced, got %v", h)
+	}
+	assertColumn(t, "name", df.Column("name"), []any{"x", ""})
+	assertColumn(t, "note", df.Column("note"), []any{"", nil})
+	assertColumn(t, "n", df.Column("n"), []any{int64(1), nil})
+
+	_, err = dataframe.LoadCSVOptions(strings.NewReader(input), dataframe.CSVOptions{
+		Headers: []string{"name"},
+	})
+	if err == nil {
+		t.Error("expected error for a header count mismatch")
+	}
+}
@@ -3,23 +3,37 @@ package dataframe
 import (
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"slices"
-	"sort"
 	"strings"
 	"time"
 )
 
+// Computed describes a column added by DataFrame.Computed. Func is called
+// with a map of every row, which boxes each value; use ComputedExpr to
+// compute the column a whole column at a time instead.
 type Computed[T any] struct {
 	Name string
 	Func func(row map[string]any) T
 }
 
+// ComputedExpr describes a column added by DataFrame.Computed from an
+// expression, evaluated like WithColumn without building a map per row.
+type ComputedExpr struct {
+	Name string
+	Expr Expr
+}
+
 type DataFrame struct {
 	data     []IColumn
 	headers  []string
 	rowCount int
 	index    map[string]int
+
+	// labels names the column holding the row labels of a Pivot or
+	// Crosstab, which Matrix leaves out.
+	labels string
 }
 
 func New() *DataFrame {
@@ -74,7 +88,7 @@ func (df *DataFrame) DeleteColumn(name string) IColumn {
 
 func (df *DataFrame) DeleteRow(index int) {
 	if index < 0 || index >= df.rowCount {
-		panic("Index out of range")
+		panic(fmt.Errorf("%w: row %d of %d", ErrIndexOutOfRange, index, df.rowCount))
 	}
 
 	for _, col := range df.data {
@@ -102,7 +116,6 @@ func (df *DataFrame) Format(wr io.Writer) {
 
 	for i := 0; i < df.rowCount; i++ {
 		for j, col := range df.data {
-			fmt.Println(col, i)
 			valStr := formatValue(col.Index(i))
 			if len(valStr) > colWidths[j] {
 				colWidths[j] = len(valStr)
@@ -129,10 +142,14 @@ func (df *DataFrame) Format(wr io.Writer) {
 }
 func formatValue(value any) string {
 	switch v := value.(type) {
+	case nil:
+		return "null"
 	case int64:
 		return fmt.Sprintf("%d", v)
 	case float64:
 		return fmt.Sprintf("%.3f", v)
+	case float32:
+		return fmt.Sprintf("%.3f", v)
 	case string:
 		return v
 	case bool:
@@ -140,23 +157,12 @@ func formatValue(value any) string {
 	case time.Time:
 		return v.Format("2006-01-02 15:04:05")
 	default:
-		return "?"
+		return fmt.Sprint(v)
 	}
 }
 
 func (df *DataFrame) FilterFunc(predicate func(row []any) bool) *DataFrame {
-	newDF := New()
-
-	newDF.headers = append([]string{}, df.headers...)
-	newDF.index = make(map[string]int)
-	for k, v := range df.index {
-		newDF.index[k] = v
-	}
-
-	newDF.data = make([]IColumn, len(df.data))
-	for i, col := range df.data {
-		newDF.data[i] = col.New()
-	}
+	rows := []int{}
 
 	for i := 0; i < df.rowCount; i++ {
 		row := make([]any, len(df.data))
@@ -165,133 +171,55 @@ func (df *DataFrame) FilterFunc(predicate func(row []any) bool) *DataFrame {
 		}
 
 		if predicate(row) {
-			for j, col := range newDF.data {
-				switch c := col.(type) {
-				case *Int:
-					c.data = append(c.data, row[j].(int64))
-				case *Float:
-					c.data = append(c.data, row[j].(float64))
-				case *String:
-					c.data = append(c.data, row[j].(string))
-				case *Bool:
-					c.data = append(c.data, row[j].(bool))
-				case *Time:
-					c.data = append(c.data, row[j].(time.Time))
-				}
-			}
-			newDF.rowCount++
+			rows = append(rows, i)
 		}
 	}
 
-	return newDF
+	return df.Take(rows)
 }
 
 type filter interface {
 	check(df *DataFrame, i int) bool
 }
 
+// Filtered returns the rows matching f. Comparisons on Int, Float, String and
+// Time columns are evaluated a column at a time, see Filter.
 func (df *DataFrame) Filtered(f filter) *DataFrame {
-	newDF := New()
-
-	newDF.headers = append([]string{}, df.headers...)
-	newDF.index = make(map[string]int)
-	for k, v := range df.index {
-		newDF.index[k] = v
+	if e, ok := filterExpr(df, f); ok {
+		return df.Filter(e)
 	}
 
-	newDF.data = make([]IColumn, len(df.data))
-	for i, col := range df.data {
-		newDF.data[i] = col.New()
-	}
+	rows := []int{}
 
-outer:
+	f = prepare(f)
 	for i := 0; i < df.rowCount; i++ {
-		row := make([]any, len(df.data))
-		for j, col := range df.data {
-			row[j] = col.Index(i)
-		}
-
-		if !f.check(df, i) {
-			continue outer
-		}
-
-		for j, col := range newDF.data {
-			switch c := col.(type) {
-			case *Int:
-				c.data = append(c.data, row[j].(int64))
-			case *Float:
-				c.data = append(c.data, row[j].(float64))
-			case *String:
-				c.data = append(c.data, row[j].(string))
-			case *Bool:
-				c.data = append(c.data, row[j].(bool))
-			case *Time:
-				c.data = append(c.data, row[j].(time.Time))
-			}
-
-			newDF.rowCount++
+		if f.check(df, i) {
+			rows = append(rows, i)
 		}
 	}
 
-	return newDF
+	return df.Take(rows)
 }
-func (df *DataFrame) SortBy(columnName string, ascending bool) {
-	colIndex, exists := df.index[columnName]
-	if !exists {
-		panic("column not found")
-	}
 
-	sortOrder := make([]int, df.rowCount)
-	for i := range sortOrder {
-		sortOrder[i] = i
-	}
+func (df *DataFrame) Take(indices []int) *DataFrame {
+	newDF := New()
 
-	switch c := df.data[colIndex].(type) {
-	case *Int:
-		sort.SliceStable(sortOrder, func(i, j int) bool {
-			if ascending {
-				return c.data[sortOrder[i]] < c.data[sortOrder[j]]
-			}
-			return c.data[sortOrder[i]] > c.data[sortOrder[j]]
-		})
-	case *Float:
-		sort.SliceStable(sortOrder, func(i, j int) bool {
-			if ascending {
-				return c.data[sortOrder[i]] < c.data[sortOrder[j]]
-			}
-			return c.data[sortOrder[i]] > c.data[sortOrder[j]]
-		})
-	case *String:
-		sort.SliceStable(sortOrder, func(i, j int) bool {
-			if ascending {
-				return c.data[sortOrder[i]] < c.data[sortOrder[j]]
-			}
-			return c.data[sortOrder[i]] > c.data[sortOrder[j]]
-		})
-	case *Time:
-		sort.SliceStable(sortOrder, func(i, j int) bool {
-			if ascending {
-				return c.data[sortOrder[i]].Before(c.data[sortOrder[j]])
-			}
-			return c.data[sortOrder[i]].After(c.data[sortOrder[j]])
-		})
-	case *Bool:
-		sort.SliceStable(sortOrder, func(i, j int) bool {
-			if ascending {
-				return !c.data[sortOrder[i]] && (c.data[sortOrder[j]])
-			}
-			return c.data[sortOrder[i]] && !(c.data[sortOrder[j]])
-		})
-	default:
+	newDF.headers = append([]string{}, df.headers...)
+	for k, v := range df.index {
+		newDF.index[k] = v
 	}
 
-	for i, col := range df.data {
-		newCol := col.Clone()
-		for j, idx := range sortOrder {
-			newCol.Set(j, col.Index(idx))
-		}
-		df.data[i] = newCol
+	newDF.data = make([]IColumn, len(df.data))
+	for j, col := range df.data {
+		newDF.data[j] = col.Take(indices)
 	}
+
+	newDF.rowCount = len(indices)
+	return newDF
+}
+
+func (df *DataFrame) SortBy(columnName string, ascending bool) {
+	df.Sort(SortKey{Column: columnName, Descending: !ascending})
 }
 
 func (df *DataFrame) SliceColumns(columns ...string) *DataFrame {
@@ -299,7 +227,7 @@ func (df *DataFrame) SliceColumns(columns ...string) *DataFrame {
 	for _, c := range columns {
 		colIndex, exists := df.index[c]
 		if !exists {
-			panic("column not found")
+			panic(fmt.Errorf("%w: %q", ErrColumnNotFound, c))
 		}
 
 		frame.AddColumn(c, df.data[colIndex].Clone())
@@ -315,72 +243,37 @@ func (df *DataFrame) IndexColumn(index int) IColumn {
 func (df *DataFrame) Column(column string) IColumn {
 	colIndex, exists := df.index[column]
 	if !exists {
-		panic("column not found")
+		panic(fmt.Errorf("%w: %q", ErrColumnNotFound, column))
 	}
 
 	return df.data[colIndex]
 }
 
 func (df *DataFrame) Row(index int) []any {
-	if index > df.rowCount {
+	if index < 0 || index >= df.rowCount {
 		return nil
 	}
 
-	row := []any{}
-
-	for i := range df.headers {
-		switch c := df.data[i].(type) {
-		case *Int:
-			row = append(row, c.data[index])
-		case *Float:
-			row = append(row, c.data[index])
-		case *String:
-			row = append(row, c.data[index])
-		case *Bool:
-			row = append(row, c.data[index])
-		case *Time:
-			row = append(row, c.data[index])
-		}
+	row := make([]any, 0, len(df.data))
+	for _, col := range df.data {
+		row = append(row, col.Index(index))
 	}
 
 	return row
 }
 
+// AppendRow adds a row, converting each value to the type of its column.
+// Missing and nil values are null.
 func (df *DataFrame) AppendRow(row ...any) {
-	if len(row) > len(df.headers) {
-		row = row[:len(df.headers)]
-	}
-
-	row = convert(row)
-
-	for i := range df.headers {
-		if row[i] == nil {
-			continue
-		}
+	n := df.rowCount
+	df.rowCount++
 
-		switch c := df.data[i].(type) {
-		case *Int:
-			v := row[i].(int64)
-			c.Append(v)
-		case *Float:
-			v := row[i].(float64)
-			c.Append(v)
-		case *Bool:
-			v := row[i].(bool)
-			c.Append(v)
-		case *String:
-			v := row[i].(string)
-			c.Append(v)
-		case *Time:
-			v := row[i].(time.Time)
-			c.Append(v)
+	for i, col := range df.data {
+		col.Extend(df.rowCount)
+		if i < len(row) && row[i] != nil {
+			col.Set(n, coerce(col, row[i]))
 		}
 	}
-
-	df.rowCount++
-	for i := range df.headers {
-		df.data[i].Extend(df.rowCount)
-	}
 }
 
 func (df *DataFrame) Rename(oldName, newName string) {
@@ -392,59 +285,27 @@ func (df *DataFrame) Rename(oldName, newName string) {
 	df.index[newName] = idx
 	df.headers[idx] = newName
 	delete(df.index, oldName)
+	if df.labels == oldName {
+		df.labels = newName
+	}
 }
 
+// Computed adds a column described by a Computed or a ComputedExpr.
 func (df *DataFrame) Computed(
 	compute any,
 	newCol ...IColumn,
 ) {
+	c, ok := compute.(computer)
+	if !ok {
+		panic(fmt.Errorf("%w: unknown column - %v", ErrTypeMismatch, reflect.TypeOf(compute)))
+	}
+
 	cols := map[string]IColumn{}
 	for i := range df.headers {
 		cols[df.headers[i]] = df.data[df.index[df.headers[i]]]
 	}
 
-	var col IColumn
-	var name string
-
-	switch c := compute.(type) {
-	case Computed[int64]:
-		name = c.Name
-		r := NewInt()
-		computeHelper(df, cols, &c, func(v int64) {
-			r.Append(v)
-		})
-		col = r
-	case Computed[float64]:
-		name = c.Name
-		r := NewFloat()
-		computeHelper(df, cols, &c, func(v float64) {
-			r.Append(v)
-		})
-		col = r
-	case Computed[bool]:
-		name = c.Name
-		r := NewBool()
-		computeHelper(df, cols, &c, func(v bool) {
-			r.Append(v)
-		})
-		col = r
-	case Computed[string]:
-		name = c.Name
-		r := NewString()
-		computeHelper(df, cols, &c, func(v string) {
-			r.Append(v)
-		})
-		col = r
-	case Computed[time.Time]:
-		name = c.Name
-		r := NewTime()
-		computeHelper(df, cols, &c, func(v time.Time) {
-			r.Append(v)
-		})
-		col = r
-	default:
-		panic(fmt.Errorf("unknown column - %v", reflect.TypeOf(c)))
-	}
+	name, col := c.compute(df, cols)
 
 	col.Extend(df.rowCount)
 	df.index[name] = len(df.data)
@@ -452,6 +313,45 @@ func (df *DataFrame) Computed(
 	df.headers = append(df.headers, name)
 }
 
+type computer interface {
+	compute(df *DataFrame, cols map[string]IColumn) (string, IColumn)
+}
+
+func (c Computed[T]) compute(df *DataFrame, cols map[string]IColumn) (string, IColumn) {
+	col := NewColumn[T]()
+	computeHelper(df, cols, &c, col.Append)
+	return c.Name, wrapColumn(col)
+}
+
+func (c ComputedExpr) compute(df *DataFrame, cols map[string]IColumn) (string, IColumn) {
+	return c.Name, df.Eval(c.Expr)
+}
+
+// wrapColumn returns the built-in column type for the element type of col,
+// or col itself for element types without one.
+func wrapColumn[T any](col *Column[T]) IColumn {
+	switch c := any(col).(type) {
+	case *Column[int64]:
+		return &Int{*c}
+	case *Column[float64]:
+		return &Float{*c}
+	case *Column[bool]:
+		return &Bool{*c}
+	case *Column[string]:
+		return &String{*c}
+	case *Column[time.Time]:
+		return &Time{*c}
+	case *Column[int32]:
+		return &Int32{*c}
+	case *Column[uint64]:
+		return &Uint64{*c}
+	case *Column[float32]:
+		return &Float32{*c}
+	default:
+		return col
+	}
+}
+
 func computeHelper[T any](
 	df *DataFrame,
 	cols map[string]IColumn,
@@ -476,8 +376,12 @@ func convert(row []any) []any {
 
 	for _, v := range row {
 		switch c := v.(type) {
-		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
-			res = append(res, int64(toInt64(c)))
+		case int, int8, int16, int32, int64, uint8, uint16, uint32:
+			res = append(res, toInt64(c))
+		case uint:
+			res = append(res, fromUint64(uint64(c)))
+		case uint64:
+			res = append(res, fromUint64(c))
 		case float32, float64:
 			switch r := c.(type) {
 			case float32:
@@ -485,13 +389,99 @@ func convert(row []any) []any {
 			case float64:
 				res = append(res, r)
 			}
-		case bool, string, time.Time:
+		default:
 			res = append(res, c)
 		}
 	}
 
 	return res
 }
+
+// coerce converts a value to the Go type stored by col where the conversion
+// is exact, widening ints to floats and narrowing them to Int32 or Uint64
+// when they fit.
+func coerce(col IColumn, value any) any {
+	v, err := convertTo(col, value)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// convertTo is coerce reporting values that col cannot store as an error.
+func convertTo(col IColumn, value any) (any, error) {
+	if value == nil || stores(col, value) {
+		return value, nil
+	}
+
+	value = convert([]any{value})[0]
+
+	switch col.(type) {
+	case *Float:
+		switch v := value.(type) {
+		case int64:
+			value = float64(v)
+		case uint64:
+			value = float64(v)
+		}
+	case *Int32:
+		if v, ok := value.(int64); ok {
+			if v < math.MinInt32 || v > math.MaxInt32 {
+				return nil, fmt.Errorf("%w: value %d overflows int32", ErrOverflow, v)
+			}
+			value = int32(v)
+		}
+	case *Uint64:
+		if v, ok := value.(int64); ok {
+			if v < 0 {
+				return nil, fmt.Errorf("%w: value %d overflows uint64", ErrOverflow, v)
+			}
+			value = uint64(v)
+		}
+	case *Float32:
+		switch v := value.(type) {
+		case int64:
+			value = float32(v)
+		case float64:
+			value = float32(v)
+		}
+	case *Decimal:
+		switch v := value.(type) {
+		case int64:
+			value = Fixed{Units: v}
+		case float64:
+			value = FixedFromFloat(v, col.(*Decimal).scale)
+		case string:
+			f, err := ParseFixed(v)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrTypeMismatch, err)
+			}
+			value = f
+		}
+	}
+
+	if !stores(col, value) {
+		return nil, fmt.Errorf("%w: cannot store %T in %T", ErrTypeMismatch, value, col)
+	}
+	return value, nil
+}
+
+// stores reports whether col.Set accepts value as is.
+func stores(col IColumn, value any) bool {
+	switch c := col.(type) {
+	case interface{ accepts(any) bool }:
+		return c.accepts(value)
+	case *List:
+		_, ok := value.([]any)
+		return ok
+	case *Struct:
+		_, ok := value.(map[string]any)
+		return ok
+	default:
+		return true
+	}
+}
+
 func toInt64(v any) int64 {
 	switch c := v.(type) {
 	case int:
@@ -504,17 +494,22 @@ func toInt64(v any) int64 {
 		return int64(c)
 	case int64:
 		return c
-	case uint:
-		return int64(c)
 	case uint8:
 		return int64(c)
 	case uint16:
 		return int64(c)
 	case uint32:
 		return int64(c)
-	case uint64:
-		return int64(c)
 	default:
 		return 0
 	}
 }
+
+// fromUint64 keeps values that do not fit in an int64 as uint64 rather than
+// letting them wrap.
+func fromUint64(v uint64) any {
+	if v > math.MaxInt64 {
+		return v
+	}
+	return int64(v)
+}
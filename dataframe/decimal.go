@@ -0,0 +1,230 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Fixed is an exact decimal number, Units scaled by 10^-Scale, so 12.34 is
+// Fixed{Units: 1234, Scale: 2}.
+type Fixed struct {
+	Units int64
+	Scale int
+}
+
+const maxScale = 18
+
+var pow10 = func() [maxScale + 1]int64 {
+	var p [maxScale + 1]int64
+	p[0] = 1
+	for i := 1; i <= maxScale; i++ {
+		p[i] = p[i-1] * 10
+	}
+	return p
+}()
+
+// ParseFixed parses a plain decimal such as "-12.340", keeping as many
+// fractional digits as the text has.
+func ParseFixed(s string) (Fixed, error) {
+	whole, frac, _ := strings.Cut(s, ".")
+	if len(frac) > maxScale {
+		return Fixed{}, fmt.Errorf("decimal %q has more than %d fractional digits", s, maxScale)
+	}
+	if frac != "" && (frac[0] == '+' || frac[0] == '-') {
+		return Fixed{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	units, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return Fixed{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	return Fixed{Units: units, Scale: len(frac)}, nil
+}
+
+// FixedFromFloat rounds v to the given number of fractional digits.
+func FixedFromFloat(v float64, scale int) Fixed {
+	units := math.Round(v * float64(pow10[scale]))
+	if math.IsNaN(units) || units >= math.MaxInt64 || units < math.MinInt64 {
+		panic(fmt.Errorf("%w: value %v overflows decimal", ErrOverflow, v))
+	}
+
+	return Fixed{Units: int64(units), Scale: scale}
+}
+
+func (f Fixed) String() string {
+	if f.Scale == 0 {
+		return strconv.FormatInt(f.Units, 10)
+	}
+
+	sign := ""
+	units := strconv.FormatUint(absUnits(f.Units), 10)
+	if f.Units < 0 {
+		sign = "-"
+	}
+	if len(units) <= f.Scale {
+		units = strings.Repeat("0", f.Scale-len(units)+1) + units
+	}
+
+	split := len(units) - f.Scale
+	return sign + units[:split] + "." + units[split:]
+}
+
+func (f Fixed) MarshalJSON() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+func (f Fixed) Float64() float64 {
+	return float64(f.Units) / float64(pow10[f.Scale])
+}
+
+// Rescale changes the number of fractional digits, rounding half away from
+// zero when digits are dropped.
+func (f Fixed) Rescale(scale int) Fixed {
+	if scale < 0 || scale > maxScale {
+		panic(fmt.Errorf("%w: decimal scale %d out of range", ErrInvalidArgument, scale))
+	}
+
+	switch {
+	case scale > f.Scale:
+		p := pow10[scale-f.Scale]
+		if f.Units > math.MaxInt64/p || f.Units < math.MinInt64/p {
+			panic(fmt.Errorf("%w: decimal %s overflows scale %d", ErrOverflow, f, scale))
+		}
+		f.Units *= p
+	case scale < f.Scale:
+		f.Units = divRound(f.Units, pow10[f.Scale-scale])
+	}
+
+	f.Scale = scale
+	return f
+}
+
+func (f Fixed) Cmp(g Fixed) int {
+	scale := max(f.Scale, g.Scale)
+	a, b := f.Rescale(scale), g.Rescale(scale)
+
+	switch {
+	case a.Units < b.Units:
+		return -1
+	case a.Units > b.Units:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Decimal stores Fixed values sharing one scale as their integer units.
+type Decimal struct {
+	Column[int64]
+	scale int
+}
+
+// NewDecimal creates a column with the given number of fractional digits
+// from values already expressed in units of 10^-scale.
+func NewDecimal(scale int, units ...int64) *Decimal {
+	if scale < 0 || scale > maxScale {
+		panic(fmt.Errorf("%w: decimal scale %d out of range", ErrInvalidArgument, scale))
+	}
+
+	return &Decimal{Column[int64]{data: units}, scale}
+}
+
+func (col *Decimal) New() IColumn {
+	return &Decimal{scale: col.scale}
+}
+
+func (col *Decimal) Scale() int {
+	return col.scale
+}
+
+func (col *Decimal) Data() []Fixed {
+	data := make([]Fixed, len(col.data))
+	for i, v := range col.data {
+		data[i] = col.fixed(v)
+	}
+	return data
+}
+
+func (col *Decimal) Index(idx int) any {
+	if col.nulls.isNull(idx) {
+		return nil
+	}
+	return col.fixed(col.data[idx])
+}
+
+func (col *Decimal) Clone() IColumn {
+	return &Decimal{col.clone(), col.scale}
+}
+
+// Set stores a Fixed value, rescaling it to the scale of the column.
+func (col *Decimal) Set(index int, value any) {
+	if value == nil {
+		col.Column.Set(index, nil)
+		return
+	}
+
+	v, ok := value.(Fixed)
+	if !ok {
+		panic(fmt.Errorf("%w: cannot store %T in Decimal", ErrTypeMismatch, value))
+	}
+	col.Column.Set(index, v.Rescale(col.scale).Units)
+}
+
+func (col *Decimal) Take(indices []int) IColumn {
+	return &Decimal{col.take(indices), col.scale}
+}
+
+func (col *Decimal) Append(value Fixed) {
+	col.Column.Append(value.Rescale(col.scale).Units)
+}
+
+func (col *Decimal) Head() (Fixed, bool) {
+	v, ok := col.Column.Head()
+	return col.fixed(v), ok
+}
+
+func (col *Decimal) Last() (Fixed, bool) {
+	v, ok := col.Column.Last()
+	return col.fixed(v), ok
+}
+
+func (col *Decimal) accepts(value any) bool {
+	_, ok := value.(Fixed)
+	return ok
+}
+
+func (col *Decimal) SortBy(asc bool) {
+	sortValues(col.data, &col.nulls, func(a, b int64) bool {
+		if asc {
+			return a < b
+		}
+		return a > b
+	})
+}
+
+func (col *Decimal) fixed(units int64) Fixed {
+	return Fixed{Units: units, Scale: col.scale}
+}
+
+func absUnits(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
+
+// divRound divides rounding half away from zero.
+func divRound(a, b int64) int64 {
+	q, r := a/b, a%b
+	if 2*absUnits(r) >= absUnits(b) {
+		if (a < 0) != (b < 0) {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
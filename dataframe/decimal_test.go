@@ -0,0 +1,192 @@
+package dataframe_test
+
+import (
+	"bytes"
+	"errors"
+	"go-numeric/dataframe"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestFixed(t *testing.T) {
+	tests := []struct {
+		in    string
+		scale int
+		out   string
+	}{
+		{"12.345", 2, "12.35"},
+		{"-12.345", 2, "-12.35"},
+		{"-0.05", 1, "-0.1"},
+		{"0.004", 2, "0.00"},
+		{"7", 3, "7.000"},
+		{"-0.5", 0, "-1"},
+	}
+
+	for _, tt := range tests {
+		f, err := dataframe.ParseFixed(tt.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := f.Rescale(tt.scale).String(); got != tt.out {
+			t.Errorf("%s at scale %d: expected %s, got %s", tt.in, tt.scale, tt.out, got)
+		}
+	}
+
+	for _, bad := range []string{"", "1.2.3", "abc", "1.-5"} {
+		if _, err := dataframe.ParseFixed(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+
+	a, _ := dataframe.ParseFixed("1.10")
+	b, _ := dataframe.ParseFixed("1.1")
+	if a.Cmp(b) != 0 {
+		t.Errorf("expected %s to equal %s", a, b)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	col := dataframe.NewDecimal(2, 1000, 2001, 333)
+	col.AppendNull()
+
+	if s := col.Sum().String(); s != "33.34" {
+		t.Errorf("unexpected sum %s", s)
+	}
+	if s := col.Mean().String(); s != "11.11" {
+		t.Errorf("unexpected mean %s", s)
+	}
+	if s := col.Median().String(); s != "10.00" {
+		t.Errorf("unexpected median %s", s)
+	}
+	if s := col.Min().String() + "/" + col.Max().String(); s != "3.33/20.01" {
+		t.Errorf("unexpected min/max %s", s)
+	}
+
+	df := dataframe.New()
+	df.AddColumn("account", dataframe.NewString("a", "b", "a", "b"))
+	df.AddColumn("amount", col)
+	df.AppendRow("a", 0.015)
+	df.AppendRow("b", "-1.50")
+
+	out := df.GroupBy("account").Agg(dataframe.Aggregation{Column: "amount", Func: dataframe.Sum})
+	assertColumn(t, "sum", out.Column("amount"), []any{
+		dataframe.Fixed{Units: 1335, Scale: 2},
+		dataframe.Fixed{Units: 1851, Scale: 2},
+	})
+
+	if n := df.Filtered(&dataframe.GTE{Column: "amount", Value: 10}).Len(); n != 2 {
+		t.Errorf("expected 2 amounts of at least 10, got %d", n)
+	}
+
+	var buf bytes.Buffer
+	if err := df.ToJSON(&buf, dataframe.JSONRecords); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"amount":20.01`) {
+		t.Errorf("expected exact decimal in %s", buf.String())
+	}
+}
+
+func TestNarrowTypes(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewUint64(1))
+	df.AddColumn("count", dataframe.NewInt32(7))
+	df.AddColumn("ratio", dataframe.NewFloat32(0.5))
+	df.AppendRow(uint64(1<<63+5), 3, 1.25)
+	df.AppendRow(nil, nil, nil)
+
+	ids := df.Column("id").(*dataframe.Uint64)
+	if v := ids.Max(); v != 1<<63+5 {
+		t.Errorf("expected id above 2^63 to survive, got %d", v)
+	}
+	if v := df.Column("count").(*dataframe.Int32).Sum(); v != 10 {
+		t.Errorf("unexpected count sum %d", v)
+	}
+	if v := df.Column("ratio").(*dataframe.Float32).Mean(); v != 0.875 {
+		t.Errorf("unexpected ratio mean %v", v)
+	}
+
+	if n := df.Filtered(&dataframe.GTE{Column: "id", Value: uint64(1 << 63)}).Len(); n != 1 {
+		t.Errorf("expected 1 id at or above 2^63, got %d", n)
+	}
+	if n := df.Filtered(&dataframe.LT{Column: "count", Value: int64(5)}).Len(); n != 1 {
+		t.Errorf("expected 1 count below 5, got %d", n)
+	}
+
+	df.Sort(dataframe.SortKey{Column: "ratio", Descending: true})
+	assertColumn(t, "sorted", df.Column("count"), []any{int32(3), int32(7), nil})
+
+	lookup := dataframe.New()
+	lookup.AddColumn("count", dataframe.NewInt(3, 7))
+	lookup.AddColumn("label", dataframe.NewString("three", "seven"))
+	joined := dataframe.Join(df, lookup, []string{"count"}, dataframe.LeftJoin)
+	assertColumn(t, "label", joined.Column("label"), []any{"three", "seven", nil})
+}
+
+func TestLoadCSVNumericSchema(t *testing.T) {
+	input := "id,price,qty\n18446744073709551615,19.99,2\n2,0.5,\n"
+
+	df, err := dataframe.LoadCSVOptions(strings.NewReader(input), dataframe.CSVOptions{
+		Schema: map[string]dataframe.IColumn{
+			"id":    dataframe.NewUint64(),
+			"price": dataframe.NewDecimal(2),
+			"qty":   dataframe.NewInt32(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertColumn(t, "id", df.Column("id"), []any{uint64(18446744073709551615), uint64(2)})
+	assertColumn(t, "price", df.Column("price"), []any{
+		dataframe.Fixed{Units: 1999, Scale: 2},
+		dataframe.Fixed{Units: 50, Scale: 2},
+	})
+	assertColumn(t, "qty", df.Column("qty"), []any{int32(2), nil})
+
+	var buf bytes.Buffer
+	if err := df.ToCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if want := "id,price,qty\n18446744073709551615,19.99,2\n2,0.50,\n"; buf.String() != want {
+		t.Errorf("unexpected CSV %q", buf.String())
+	}
+}
+
+func TestReducerEdgeCases(t *testing.T) {
+	expectPanic := func(name string, target error, f func()) {
+		t.Helper()
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, target) {
+				t.Errorf("%s: expected %v, got %v", name, target, err)
+			}
+		}()
+		f()
+	}
+
+	expectPanic("Float.Mean", dataframe.ErrEmptyColumn, func() { dataframe.NewFloat().Mean() })
+	expectPanic("Uint64.Sum", dataframe.ErrOverflow, func() {
+		dataframe.NewUint64(math.MaxUint64, 1).Sum()
+	})
+	expectPanic("Decimal.Sum", dataframe.ErrOverflow, func() {
+		dataframe.NewDecimal(2, math.MaxInt64, 1).Sum()
+	})
+	if _, err := dataframe.TrySum(dataframe.NewInt(math.MinInt64, -1)); !errors.Is(err, dataframe.ErrOverflow) {
+		t.Errorf("TrySum: expected ErrOverflow, got %v", err)
+	}
+	if v := dataframe.NewInt(math.MinInt64, -1).Sum(); v != math.MaxInt64 {
+		t.Errorf("Int.Sum: expected to wrap around, got %v", v)
+	}
+	if v := dataframe.NewInt().Mean(); v != 0 {
+		t.Errorf("Int.Mean: expected 0 for an empty column, got %v", v)
+	}
+
+	if v := dataframe.NewInt(math.MaxInt64, math.MaxInt64).Median(); v != math.MaxInt64 {
+		t.Errorf("Int.Median: unexpected %v", v)
+	}
+	if v := dataframe.NewUint64(math.MaxUint64, 0).Sum(); v != math.MaxUint64 {
+		t.Errorf("Uint64.Sum: unexpected %v", v)
+	}
+}
@@ -0,0 +1,188 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Errors reported by the Try functions and carried by the panics of their
+// counterparts, so either can be checked with errors.Is.
+var (
+	ErrColumnNotFound  = errors.New("column not found")
+	ErrTypeMismatch    = errors.New("type mismatch")
+	ErrLengthMismatch  = errors.New("length mismatch")
+	ErrEmptyColumn     = errors.New("empty column")
+	ErrIndexOutOfRange = errors.New("index out of range")
+	ErrOverflow        = errors.New("overflow")
+	ErrSchemaViolation = errors.New("schema violation")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrDivisionByZero  = errors.New("division by zero")
+)
+
+func (df *DataFrame) TryColumn(name string) (IColumn, error) {
+	idx, ok := df.index[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrColumnNotFound, name)
+	}
+	return df.data[idx], nil
+}
+
+func TryColumnAs[T any](df *DataFrame, name string) (col *Column[T], err error) {
+	err = catch(func() { col = ColumnAs[T](df, name) })
+	return col, err
+}
+
+func (df *DataFrame) TrySliceColumns(columns ...string) (out *DataFrame, err error) {
+	err = catch(func() { out = df.SliceColumns(columns...) })
+	return out, err
+}
+
+// TrySort works out the order before moving any row, so a failed sort
+// leaves the frame unchanged.
+func (df *DataFrame) TrySort(keys ...SortKey) error {
+	var order []int
+	if err := catch(func() { order = df.Argsort(keys...) }); err != nil {
+		return err
+	}
+
+	for i, col := range df.data {
+		df.data[i] = col.Take(order)
+	}
+	return nil
+}
+
+func (df *DataFrame) TrySortBy(column string, ascending bool) error {
+	return df.TrySort(SortKey{Column: column, Descending: !ascending})
+}
+
+// TryComputed builds the whole column before adding it, so a failed compute
+// leaves the frame unchanged.
+func (df *DataFrame) TryComputed(compute any) error {
+	if _, ok := compute.(computer); !ok {
+		return fmt.Errorf("%w: unknown column - %v", ErrTypeMismatch, reflect.TypeOf(compute))
+	}
+	return catch(func() { df.Computed(compute) })
+}
+
+// TryWithColumn type checks e before evaluating it, and the frame only
+// changes once the column is complete.
+func (df *DataFrame) TryWithColumn(name string, e Expr) error {
+	if err := e.Check(df); err != nil {
+		return err
+	}
+	return catch(func() { df.WithColumn(name, e) })
+}
+
+func (df *DataFrame) TryFilter(e Expr) (out *DataFrame, err error) {
+	err = catch(func() { out = df.Filter(e) })
+	return out, err
+}
+
+// TryAppendRow checks every value against its column before appending, so a
+// failed row leaves the frame unchanged.
+func (df *DataFrame) TryAppendRow(row ...any) error {
+	for i, col := range df.data {
+		if i >= len(row) || row[i] == nil {
+			continue
+		}
+		if _, err := convertTo(col, row[i]); err != nil {
+			return fmt.Errorf("column %q: %w", df.headers[i], err)
+		}
+	}
+
+	df.AppendRow(row...)
+	return nil
+}
+
+// TrySet converts value to the type of col like AppendRow does and stores it.
+func TrySet(col IColumn, index int, value any) error {
+	if index < 0 || index >= col.Len() {
+		return fmt.Errorf("%w: row %d of %d", ErrIndexOutOfRange, index, col.Len())
+	}
+
+	v, err := convertTo(col, value)
+	if err != nil {
+		return err
+	}
+	return catch(func() { col.Set(index, v) })
+}
+
+// TrySum reports ErrOverflow where Int.Sum would wrap around.
+func TrySum(col IColumn) (any, error) {
+	if c, ok := col.(*Int); ok {
+		var v int64
+		err := catch(func() { v = sumChecked(c.data, &c.nulls) })
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	return tryReduce(Sum, col, 0)
+}
+
+func TryMean(col IColumn) (any, error) {
+	return tryReduce(Mean, col, 1)
+}
+
+func TryMedian(col IColumn) (any, error) {
+	return tryReduce(Median, col, 1)
+}
+
+// TryStd needs at least two values.
+func TryStd(col IColumn) (any, error) {
+	return tryReduce(Std, col, 2)
+}
+
+func TryMin(col IColumn) (any, error) {
+	return tryReduce(Min, col, 1)
+}
+
+func TryMax(col IColumn) (any, error) {
+	return tryReduce(Max, col, 1)
+}
+
+func tryReduce(f Reducer, col IColumn, minValues int) (v any, err error) {
+	if n := col.Len() - col.NullCount(); n < minValues {
+		return nil, fmt.Errorf("%w: %d values, need %d", ErrEmptyColumn, n, minValues)
+	}
+
+	err = catch(func() { v = f(col) })
+	return v, err
+}
+
+func sameLength(a, b IColumn) {
+	if a.Len() != b.Len() {
+		panic(fmt.Errorf("%w: %d and %d rows", ErrLengthMismatch, a.Len(), b.Len()))
+	}
+}
+
+// catch runs f and returns the error it panics with when that error wraps
+// one of the errors above. Any other panic is passed on.
+func catch(f func()) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		e, ok := r.(error)
+		if !ok {
+			panic(r)
+		}
+		for _, target := range []error{
+			ErrColumnNotFound, ErrTypeMismatch, ErrLengthMismatch,
+			ErrEmptyColumn, ErrIndexOutOfRange, ErrOverflow,
+			ErrSchemaViolation, ErrInvalidArgument, ErrDivisionByZero,
+		} {
+			if errors.Is(e, target) {
+				err = e
+				return
+			}
+		}
+		panic(r)
+	}()
+
+	f()
+	return nil
+}
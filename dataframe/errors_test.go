@@ -0,0 +1,149 @@
+package dataframe_test
+
+import (
+	"errors"
+	"fmt"
+	"go-numeric/dataframe"
+	"testing"
+)
+
+func TestTryErrors(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewInt(1, 2, 3))
+	df.AddColumn("name", dataframe.NewString("a", "b", "c"))
+	df.AddColumn("tags", dataframe.NewList(dataframe.NewString("x"), 1, 0, 0))
+
+	if _, err := df.TryColumn("missing"); !errors.Is(err, dataframe.ErrColumnNotFound) {
+		t.Errorf("TryColumn: expected ErrColumnNotFound, got %v", err)
+	}
+	if col, err := df.TryColumn("id"); err != nil || col.Len() != 3 {
+		t.Errorf("TryColumn: unexpected %v, %v", col, err)
+	}
+	if _, err := df.TrySliceColumns("id", "missing"); !errors.Is(err, dataframe.ErrColumnNotFound) {
+		t.Errorf("TrySliceColumns: expected ErrColumnNotFound, got %v", err)
+	}
+	if _, err := dataframe.TryColumnAs[float64](df, "id"); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("TryColumnAs: expected ErrTypeMismatch, got %v", err)
+	}
+
+	if err := df.TrySortBy("missing", true); !errors.Is(err, dataframe.ErrColumnNotFound) {
+		t.Errorf("TrySortBy: expected ErrColumnNotFound, got %v", err)
+	}
+	if err := df.TrySortBy("tags", true); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("TrySortBy: expected ErrTypeMismatch, got %v", err)
+	}
+	if err := df.TrySortBy("id", false); err != nil {
+		t.Errorf("TrySortBy: unexpected %v", err)
+	}
+
+	if err := df.TryComputed(42); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("TryComputed: expected ErrTypeMismatch, got %v", err)
+	}
+
+	id := df.Column("id")
+	if err := dataframe.TrySet(id, 0, "x"); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("TrySet: expected ErrTypeMismatch, got %v", err)
+	}
+	if err := dataframe.TrySet(id, 5, 1); !errors.Is(err, dataframe.ErrIndexOutOfRange) {
+		t.Errorf("TrySet: expected ErrIndexOutOfRange, got %v", err)
+	}
+	if err := dataframe.TrySet(id, 0, int32(7)); err != nil || id.Index(0) != int64(7) {
+		t.Errorf("TrySet: unexpected %v, %v", id.Index(0), err)
+	}
+
+	if err := df.TryAppendRow(4, 5, nil); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("TryAppendRow: expected ErrTypeMismatch, got %v", err)
+	}
+	if df.Len() != 3 {
+		t.Errorf("TryAppendRow: failed row was appended, %d rows", df.Len())
+	}
+
+	small := dataframe.New()
+	small.AddColumn("n", dataframe.NewInt32())
+	small.AppendRow(1)
+	if err := small.TryAppendRow(1 << 40); !errors.Is(err, dataframe.ErrOverflow) {
+		t.Errorf("TryAppendRow: expected ErrOverflow, got %v", err)
+	}
+}
+
+func TestTryReducers(t *testing.T) {
+	empty := dataframe.NewFloat()
+	for name, f := range map[string]func(dataframe.IColumn) (any, error){
+		"min": dataframe.TryMin, "max": dataframe.TryMax, "mean": dataframe.TryMean,
+		"median": dataframe.TryMedian, "std": dataframe.TryStd,
+	} {
+		if _, err := f(empty); !errors.Is(err, dataframe.ErrEmptyColumn) {
+			t.Errorf("%s: expected ErrEmptyColumn, got %v", name, err)
+		}
+	}
+
+	if v, err := dataframe.TrySum(empty); err != nil || v != 0.0 {
+		t.Errorf("sum: unexpected %v, %v", v, err)
+	}
+	if _, err := dataframe.TrySum(dataframe.NewString("a")); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("sum: expected ErrTypeMismatch, got %v", err)
+	}
+	if v, err := dataframe.TryMax(dataframe.NewInt(3, 9, 4)); err != nil || v != int64(9) {
+		t.Errorf("max: unexpected %v, %v", v, err)
+	}
+}
+
+func TestPanicsCarryErrors(t *testing.T) {
+	expectPanic := func(name string, target error, f func()) {
+		t.Helper()
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, target) {
+				t.Errorf("%s: expected %v, got %v", name, target, err)
+			}
+		}()
+		f()
+	}
+
+	df := dataframe.New()
+	df.AddColumn("a", dataframe.NewInt(1, 2))
+
+	expectPanic("Column", dataframe.ErrColumnNotFound, func() { df.Column("b") })
+	expectPanic("Set", dataframe.ErrTypeMismatch, func() { df.Column("a").Set(0, 1.5) })
+	expectPanic("Add", dataframe.ErrLengthMismatch, func() {
+		dataframe.NewInt(1, 2).Add(dataframe.NewInt(1))
+	})
+	expectPanic("Int.Min", dataframe.ErrEmptyColumn, func() { dataframe.NewInt().Min() })
+
+	num := dataframe.NewInt(6, 8)
+	expectPanic("Div", dataframe.ErrDivisionByZero, func() { num.Div(dataframe.NewInt(2, 0)) })
+	assertColumn(t, "Div", num, []any{int64(6), int64(8)})
+
+	expectPanic("Rolling", dataframe.ErrInvalidArgument, func() { df.Rolling("a", 0) })
+	expectPanic("Resample", dataframe.ErrInvalidArgument, func() { df.Resample("a", 0) })
+	expectPanic("NewDecimal", dataframe.ErrInvalidArgument, func() { dataframe.NewDecimal(-1) })
+	expectPanic("Reorder", dataframe.ErrInvalidArgument, func() {
+		dataframe.NewString("a", "b").Categorical().Reorder("a")
+	})
+}
+
+func TestTryLeavesFrameUnchanged(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("a", dataframe.NewInt(2, 1))
+	df.AddColumn("tags", dataframe.NewList(dataframe.NewString("x"), 1, 0))
+
+	if err := df.TrySort(dataframe.SortKey{Column: "a"}, dataframe.SortKey{Column: "tags"}); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("TrySort: expected ErrTypeMismatch, got %v", err)
+	}
+	assertColumn(t, "TrySort", df.Column("a"), []any{int64(2), int64(1)})
+
+	if err := df.TryWithColumn("a", dataframe.Col("a").Add(dataframe.Col("tags"))); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("TryWithColumn: expected ErrTypeMismatch, got %v", err)
+	}
+	assertColumn(t, "TryWithColumn", df.Column("a"), []any{int64(2), int64(1)})
+
+	fail := dataframe.Computed[int64]{Name: "b", Func: func(row map[string]any) int64 {
+		panic(fmt.Errorf("%w: no b for %v", dataframe.ErrSchemaViolation, row["a"]))
+	}}
+	if err := df.TryComputed(fail); !errors.Is(err, dataframe.ErrSchemaViolation) {
+		t.Errorf("TryComputed: expected ErrSchemaViolation, got %v", err)
+	}
+	if df.NumColumns() != 2 {
+		t.Errorf("TryComputed: failed column was added, %v", df.Headers())
+	}
+}
@@ -0,0 +1,614 @@
+package dataframe
+
+import (
+	"fmt"
+	"time"
+)
+
+// Expr is a column expression such as Col("a").Add(Col("b")).Gt(Lit(10)).
+// It is type checked against a frame once and then evaluated a whole column
+// at a time, so no value is boxed per row.
+//
+// Int and Int32 columns are ints, Float and Float32 columns are floats and
+// Categorical columns are strings. Arithmetic on two ints gives an int except
+// for Div, which always gives a float. Any null operand gives a null, apart
+// from And and Or which follow SQL: false And null is false, true Or null is
+// true.
+type Expr struct {
+	node node
+}
+
+type kind int
+
+const (
+	kindInt kind = iota
+	kindFloat
+	kindBool
+	kindString
+	kindTime
+)
+
+func (k kind) String() string {
+	switch k {
+	case kindInt:
+		return "int"
+	case kindFloat:
+		return "float"
+	case kindBool:
+		return "bool"
+	case kindString:
+		return "string"
+	default:
+		return "time"
+	}
+}
+
+func (k kind) numeric() bool {
+	return k == kindInt || k == kindFloat
+}
+
+type node interface {
+	// check returns the kind of the values the node produces for df.
+	check(df *DataFrame) (kind, error)
+	// eval returns an Int, Float, Bool, String or Time column with one row
+	// per row of df. It is only called after check succeeds.
+	eval(df *DataFrame) IColumn
+	String() string
+}
+
+// Col refers to the named column.
+func Col(name string) Expr {
+	return Expr{colNode{name}}
+}
+
+// Lit is a constant of one of the Go types AppendRow accepts for Int, Float,
+// Bool, String or Time columns.
+func Lit(value any) Expr {
+	return Expr{litNode{convert([]any{value})[0]}}
+}
+
+func (e Expr) Add(other Expr) Expr { return e.arith(opAdd, other) }
+func (e Expr) Sub(other Expr) Expr { return e.arith(opSub, other) }
+func (e Expr) Mul(other Expr) Expr { return e.arith(opMul, other) }
+func (e Expr) Div(other Expr) Expr { return e.arith(opDiv, other) }
+
+func (e Expr) Eq(other Expr) Expr { return e.compare(opEq, other) }
+func (e Expr) Ne(other Expr) Expr { return e.compare(opNe, other) }
+func (e Expr) Lt(other Expr) Expr { return e.compare(opLt, other) }
+func (e Expr) Le(other Expr) Expr { return e.compare(opLe, other) }
+func (e Expr) Gt(other Expr) Expr { return e.compare(opGt, other) }
+func (e Expr) Ge(other Expr) Expr { return e.compare(opGe, other) }
+
+func (e Expr) And(other Expr) Expr { return Expr{logicNode{opAnd, e.node, other.node}} }
+func (e Expr) Or(other Expr) Expr  { return Expr{logicNode{opOr, e.node, other.node}} }
+func (e Expr) Not() Expr           { return Expr{notNode{e.node}} }
+
+func (e Expr) IsNull() Expr    { return Expr{nullNode{e.node, false}} }
+func (e Expr) IsNotNull() Expr { return Expr{nullNode{e.node, true}} }
+
+func (e Expr) arith(op op, other Expr) Expr {
+	return Expr{arithNode{op, e.node, other.node}}
+}
+
+func (e Expr) compare(op op, other Expr) Expr {
+	return Expr{compareNode{op, e.node, other.node}}
+}
+
+// Check type checks the expression against df, reporting unknown columns with
+// ErrColumnNotFound and invalid operands with ErrTypeMismatch.
+func (e Expr) Check(df *DataFrame) error {
+	_, err := e.check(df)
+	return err
+}
+
+func (e Expr) check(df *DataFrame) (kind, error) {
+	if e.node == nil {
+		return 0, fmt.Errorf("%w: empty expression", ErrTypeMismatch)
+	}
+	return e.node.check(df)
+}
+
+func (e Expr) String() string {
+	if e.node == nil {
+		return "<nil>"
+	}
+	return e.node.String()
+}
+
+// Eval evaluates the expression over every row of df into a new Int, Float,
+// Bool, String or Time column. It panics if the expression does not type
+// check.
+func (df *DataFrame) Eval(e Expr) IColumn {
+	if _, err := e.check(df); err != nil {
+		panic(err)
+	}
+
+	col := e.node.eval(df)
+	if _, ok := e.node.(colNode); ok {
+		col = col.Clone()
+	}
+	return col
+}
+
+// WithColumn evaluates e and stores the result as the named column,
+// replacing any column of that name.
+func (df *DataFrame) WithColumn(name string, e Expr) {
+	col := df.Eval(e)
+	if idx, ok := df.index[name]; ok {
+		df.data[idx] = col
+		return
+	}
+	df.AddColumn(name, col)
+}
+
+// Filter returns the rows for which the bool expression e is true. Rows where
+// it is null are dropped.
+func (df *DataFrame) Filter(e Expr) *DataFrame {
+	k, err := e.check(df)
+	if err == nil && k != kindBool {
+		err = fmt.Errorf("%w: filter %s is %v, not bool", ErrTypeMismatch, e, k)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	mask := e.node.eval(df).(*Bool)
+	rows := []int{}
+	for i, v := range mask.data {
+		if v && !mask.nulls.isNull(i) {
+			rows = append(rows, i)
+		}
+	}
+
+	return df.Take(rows)
+}
+
+type op int
+
+const (
+	opAdd op = iota
+	opSub
+	opMul
+	opDiv
+	opEq
+	opNe
+	opLt
+	opLe
+	opGt
+	opGe
+	opAnd
+	opOr
+)
+
+func (o op) String() string {
+	return [...]string{"+", "-", "*", "/", "==", "!=", "<", "<=", ">", ">=", "and", "or"}[o]
+}
+
+type colNode struct {
+	name string
+}
+
+func (n colNode) check(df *DataFrame) (kind, error) {
+	idx, ok := df.index[n.name]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrColumnNotFound, n.name)
+	}
+
+	switch col := df.data[idx].(type) {
+	case *Int, *Int32:
+		return kindInt, nil
+	case *Float, *Float32:
+		return kindFloat, nil
+	case *Bool:
+		return kindBool, nil
+	case *String, *Categorical:
+		return kindString, nil
+	case *Time:
+		return kindTime, nil
+	default:
+		return 0, fmt.Errorf("%w: column %q is %T", ErrTypeMismatch, n.name, col)
+	}
+}
+
+func (n colNode) eval(df *DataFrame) IColumn {
+	switch col := df.data[df.index[n.name]].(type) {
+	case *Int32:
+		data, nulls := mapValues(col.data, &col.nulls, func(v int32) int64 { return int64(v) })
+		return &Int{Column[int64]{data: data, nulls: nulls}}
+	case *Float32:
+		data, nulls := mapValues(col.data, &col.nulls, func(v float32) float64 { return float64(v) })
+		return &Float{Column[float64]{data: data, nulls: nulls}}
+	case *Categorical:
+		return col.Strings()
+	default:
+		return col
+	}
+}
+
+func (n colNode) String() string {
+	return n.name
+}
+
+type litNode struct {
+	value any
+}
+
+func (n litNode) check(df *DataFrame) (kind, error) {
+	switch n.value.(type) {
+	case int64:
+		return kindInt, nil
+	case float64:
+		return kindFloat, nil
+	case bool:
+		return kindBool, nil
+	case string:
+		return kindString, nil
+	case time.Time:
+		return kindTime, nil
+	default:
+		return 0, fmt.Errorf("%w: literal %v of type %T", ErrTypeMismatch, n.value, n.value)
+	}
+}
+
+func (n litNode) eval(df *DataFrame) IColumn {
+	switch v := n.value.(type) {
+	case int64:
+		return &Int{repeat(v, df.rowCount)}
+	case float64:
+		return &Float{repeat(v, df.rowCount)}
+	case bool:
+		return &Bool{repeat(v, df.rowCount)}
+	case string:
+		return &String{repeat(v, df.rowCount)}
+	default:
+		return &Time{repeat(v.(time.Time), df.rowCount)}
+	}
+}
+
+func (n litNode) String() string {
+	if s, ok := n.value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return formatText(n.value)
+}
+
+type arithNode struct {
+	op          op
+	left, right node
+}
+
+func (n arithNode) check(df *DataFrame) (kind, error) {
+	l, r, err := checkOperands(df, n.left, n.right)
+	if err != nil {
+		return 0, err
+	}
+	if !l.numeric() || !r.numeric() {
+		return 0, fmt.Errorf("%w: %s needs numbers, got %v and %v", ErrTypeMismatch, n, l, r)
+	}
+
+	if l == kindInt && r == kindInt && n.op != opDiv {
+		return kindInt, nil
+	}
+	return kindFloat, nil
+}
+
+func (n arithNode) eval(df *DataFrame) IColumn {
+	l, r := n.left.eval(df), n.right.eval(df)
+
+	a, aInt := l.(*Int)
+	b, bInt := r.(*Int)
+	if aInt && bInt && n.op != opDiv {
+		return &Int{zipValues(&a.Column, &b.Column, arithFunc[int64](n.op))}
+	}
+
+	x, y := floats(l), floats(r)
+	return &Float{zipValues(&x.Column, &y.Column, arithFunc[float64](n.op))}
+}
+
+func (n arithNode) String() string {
+	return fmt.Sprintf("(%s %v %s)", n.left, n.op, n.right)
+}
+
+func arithFunc[T int64 | float64](o op) func(x, y T) T {
+	switch o {
+	case opAdd:
+		return func(x, y T) T { return x + y }
+	case opSub:
+		return func(x, y T) T { return x - y }
+	case opMul:
+		return func(x, y T) T { return x * y }
+	default:
+		return func(x, y T) T { return x / y }
+	}
+}
+
+type compareNode struct {
+	op          op
+	left, right node
+}
+
+func (n compareNode) check(df *DataFrame) (kind, error) {
+	l, r, err := checkOperands(df, n.left, n.right)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case l.numeric() && r.numeric():
+	case l != r:
+		return 0, fmt.Errorf("%w: cannot compare %v and %v in %s", ErrTypeMismatch, l, r, n)
+	case l == kindBool && n.op != opEq && n.op != opNe:
+		return 0, fmt.Errorf("%w: bools only support == and != in %s", ErrTypeMismatch, n)
+	}
+	return kindBool, nil
+}
+
+func (n compareNode) eval(df *DataFrame) IColumn {
+	l, r := n.left.eval(df), n.right.eval(df)
+
+	switch a := l.(type) {
+	case *Int:
+		if b, ok := r.(*Int); ok {
+			return &Bool{zipValues(&a.Column, &b.Column, orderFunc[int64](n.op))}
+		}
+	case *String:
+		return &Bool{zipValues(&a.Column, &r.(*String).Column, orderFunc[string](n.op))}
+	case *Time:
+		return &Bool{zipValues(&a.Column, &r.(*Time).Column, timeFunc(n.op))}
+	case *Bool:
+		eq := n.op == opEq
+		return &Bool{zipValues(&a.Column, &r.(*Bool).Column, func(x, y bool) bool {
+			return (x == y) == eq
+		})}
+	}
+
+	x, y := floats(l), floats(r)
+	return &Bool{zipValues(&x.Column, &y.Column, orderFunc[float64](n.op))}
+}
+
+func (n compareNode) String() string {
+	return fmt.Sprintf("(%s %v %s)", n.left, n.op, n.right)
+}
+
+func orderFunc[T int64 | float64 | string](o op) func(x, y T) bool {
+	switch o {
+	case opEq:
+		return func(x, y T) bool { return x == y }
+	case opNe:
+		return func(x, y T) bool { return x != y }
+	case opLt:
+		return func(x, y T) bool { return x < y }
+	case opLe:
+		return func(x, y T) bool { return x <= y }
+	case opGt:
+		return func(x, y T) bool { return x > y }
+	default:
+		return func(x, y T) bool { return x >= y }
+	}
+}
+
+func timeFunc(o op) func(x, y time.Time) bool {
+	switch o {
+	case opEq:
+		return func(x, y time.Time) bool { return x.Equal(y) }
+	case opNe:
+		return func(x, y time.Time) bool { return !x.Equal(y) }
+	case opLt:
+		return func(x, y time.Time) bool { return x.Before(y) }
+	case opLe:
+		return func(x, y time.Time) bool { return !x.After(y) }
+	case opGt:
+		return func(x, y time.Time) bool { return x.After(y) }
+	default:
+		return func(x, y time.Time) bool { return !x.Before(y) }
+	}
+}
+
+type logicNode struct {
+	op          op
+	left, right node
+}
+
+func (n logicNode) check(df *DataFrame) (kind, error) {
+	l, r, err := checkOperands(df, n.left, n.right)
+	if err != nil {
+		return 0, err
+	}
+	if l != kindBool || r != kindBool {
+		return 0, fmt.Errorf("%w: %s needs bools, got %v and %v", ErrTypeMismatch, n, l, r)
+	}
+	return kindBool, nil
+}
+
+func (n logicNode) eval(df *DataFrame) IColumn {
+	a, b := n.left.eval(df).(*Bool), n.right.eval(df).(*Bool)
+
+	// a value that decides the result on its own wins over a null
+	decisive := n.op == opOr
+	out := &Bool{Column[bool]{data: make([]bool, len(a.data))}}
+	for i := range out.data {
+		aNull, bNull := a.nulls.isNull(i), b.nulls.isNull(i)
+		switch {
+		case !aNull && a.data[i] == decisive, !bNull && b.data[i] == decisive:
+			out.data[i] = decisive
+		case aNull || bNull:
+			out.nulls.set(i, true)
+		default:
+			out.data[i] = !decisive
+		}
+	}
+	return out
+}
+
+func (n logicNode) String() string {
+	return fmt.Sprintf("(%s %v %s)", n.left, n.op, n.right)
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) check(df *DataFrame) (kind, error) {
+	k, _, err := checkOperands(df, n.operand)
+	if err != nil {
+		return 0, err
+	}
+	if k != kindBool {
+		return 0, fmt.Errorf("%w: %s needs a bool, got %v", ErrTypeMismatch, n, k)
+	}
+	return kindBool, nil
+}
+
+func (n notNode) eval(df *DataFrame) IColumn {
+	col := n.operand.eval(df).(*Bool)
+	data, nulls := mapValues(col.data, &col.nulls, func(v bool) bool { return !v })
+	return &Bool{Column[bool]{data: data, nulls: nulls}}
+}
+
+func (n notNode) String() string {
+	return fmt.Sprintf("not %s", n.operand)
+}
+
+type nullNode struct {
+	operand node
+	not     bool
+}
+
+func (n nullNode) check(df *DataFrame) (kind, error) {
+	if _, _, err := checkOperands(df, n.operand); err != nil {
+		return 0, err
+	}
+	return kindBool, nil
+}
+
+func (n nullNode) eval(df *DataFrame) IColumn {
+	col := n.operand.eval(df)
+	out := &Bool{Column[bool]{data: make([]bool, col.Len())}}
+	for i := range out.data {
+		out.data[i] = col.IsNull(i) != n.not
+	}
+	return out
+}
+
+func (n nullNode) String() string {
+	if n.not {
+		return fmt.Sprintf("%s is not null", n.operand)
+	}
+	return fmt.Sprintf("%s is null", n.operand)
+}
+
+// checkOperands checks nodes in order, returning the kind of the first node
+// and the second when there is one.
+func checkOperands(df *DataFrame, nodes ...node) (kind, kind, error) {
+	kinds := make([]kind, 2)
+	for i, n := range nodes {
+		if n == nil {
+			return 0, 0, fmt.Errorf("%w: empty expression", ErrTypeMismatch)
+		}
+
+		k, err := n.check(df)
+		if err != nil {
+			return 0, 0, err
+		}
+		kinds[i] = k
+	}
+	return kinds[0], kinds[1], nil
+}
+
+// floats returns an Int or Float column as a Float column.
+func floats(col IColumn) *Float {
+	if c, ok := col.(*Float); ok {
+		return c
+	}
+
+	c := col.(*Int)
+	data, nulls := mapValues(c.data, &c.nulls, func(v int64) float64 { return float64(v) })
+	return &Float{Column[float64]{data: data, nulls: nulls}}
+}
+
+// zipValues applies f to the values of a and b row by row. A null in either
+// gives a null.
+func zipValues[T, U any](a, b *Column[T], f func(x, y T) U) Column[U] {
+	out := Column[U]{data: make([]U, len(a.data)), nulls: unionNulls(&a.nulls, &b.nulls)}
+	for i := range out.data {
+		if !out.nulls.isNull(i) {
+			out.data[i] = f(a.data[i], b.data[i])
+		}
+	}
+	return out
+}
+
+func repeat[T any](v T, n int) Column[T] {
+	data := make([]T, n)
+	for i := range data {
+		data[i] = v
+	}
+	return Column[T]{data: data}
+}
+
+// filterExpr translates the comparison filters on Int, Float, String and Time
+// columns, and And and Or of them, into an expression so Filtered can
+// evaluate them a column at a time.
+func filterExpr(df *DataFrame, f filter) (Expr, bool) {
+	switch c := f.(type) {
+	case *EQ:
+		return compareFilter(df, c.Column, c.Value, Expr.Eq)
+	case *NEQ:
+		return compareFilter(df, c.Column, c.Value, Expr.Ne)
+	case *LT:
+		return compareFilter(df, c.Column, c.Value, Expr.Lt)
+	case *LTE:
+		return compareFilter(df, c.Column, c.Value, Expr.Le)
+	case *GT:
+		return compareFilter(df, c.Column, c.Value, Expr.Gt)
+	case *GTE:
+		return compareFilter(df, c.Column, c.Value, Expr.Ge)
+	case *IsNull:
+		_, ok := df.index[c.Column]
+		return Col(c.Column).IsNull(), ok
+	case *NotNull:
+		_, ok := df.index[c.Column]
+		return Col(c.Column).IsNotNull(), ok
+	case *And:
+		return joinFilters(df, c.filters, Expr.And)
+	case *Or:
+		return joinFilters(df, c.filters, Expr.Or)
+	default:
+		return Expr{}, false
+	}
+}
+
+func compareFilter(df *DataFrame, column string, value any, op func(Expr, Expr) Expr) (Expr, bool) {
+	idx, ok := df.index[column]
+	if !ok {
+		return Expr{}, false
+	}
+
+	switch df.data[idx].(type) {
+	case *Int, *Float, *String, *Time:
+		e := op(Col(column), Lit(value))
+		return e, e.Check(df) == nil
+	default:
+		return Expr{}, false
+	}
+}
+
+func joinFilters(df *DataFrame, filters []filter, join func(Expr, Expr) Expr) (Expr, bool) {
+	if len(filters) == 0 {
+		return Expr{}, false
+	}
+
+	var out Expr
+	for i, f := range filters {
+		e, ok := filterExpr(df, f)
+		if !ok {
+			return Expr{}, false
+		}
+		if i == 0 {
+			out = e
+		} else {
+			out = join(out, e)
+		}
+	}
+	return out, true
+}
@@ -0,0 +1,127 @@
+package dataframe_test
+
+import (
+	"errors"
+	"go-numeric/dataframe"
+	"testing"
+	"time"
+)
+
+func exprFrame() *dataframe.DataFrame {
+	df := dataframe.New()
+	df.AddColumn("a", dataframe.NewInt(1, 2, 3, 4))
+	df.AddColumn("b", dataframe.NewInt(4, 5, 6, 7))
+	df.AddColumn("x", dataframe.NewFloat(0.5, 1.5, 2.5, 3.5))
+	df.AddColumn("name", dataframe.NewString("ann", "bob", "cy", "dee"))
+	df.Column("b").Set(2, nil)
+	return df
+}
+
+func TestExprWithColumn(t *testing.T) {
+	df := exprFrame()
+
+	df.WithColumn("c", dataframe.Col("a").Add(dataframe.Col("b")).Mul(dataframe.Lit(2)))
+	assertColumn(t, "c", df.Column("c"), []any{int64(10), int64(14), nil, int64(22)})
+
+	df.WithColumn("d", dataframe.Col("a").Div(dataframe.Lit(2)).Sub(dataframe.Col("x")))
+	assertColumn(t, "d", df.Column("d"), []any{0.0, -0.5, -1.0, -1.5})
+
+	df.WithColumn("a", dataframe.Col("a").Mul(dataframe.Col("a")))
+	assertColumn(t, "a", df.Column("a"), []any{int64(1), int64(4), int64(9), int64(16)})
+	if df.NumColumns() != 6 {
+		t.Errorf("expected a to be replaced, got %v", df.Headers())
+	}
+
+	df.WithColumn("copy", dataframe.Col("name"))
+	df.Column("copy").Set(0, "zed")
+	if df.Column("name").Index(0) != "ann" {
+		t.Error("column expression aliases its source")
+	}
+}
+
+func TestExprComputed(t *testing.T) {
+	df := exprFrame()
+
+	df.Computed(dataframe.ComputedExpr{Name: "sum", Expr: dataframe.Col("a").Add(dataframe.Col("b"))})
+	assertColumn(t, "sum", df.Column("sum"), []any{int64(5), int64(7), nil, int64(11)})
+
+	wrong := dataframe.ComputedExpr{Name: "bad", Expr: dataframe.Col("a").Add(dataframe.Col("name"))}
+	if err := df.TryComputed(wrong); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("expected ErrTypeMismatch, got %v", err)
+	}
+	if df.NumColumns() != 5 {
+		t.Errorf("failed expression added a column, %v", df.Headers())
+	}
+}
+
+func TestExprFilter(t *testing.T) {
+	df := exprFrame()
+
+	e := dataframe.Col("a").Add(dataframe.Col("b")).Mul(dataframe.Lit(2)).Gt(dataframe.Lit(10))
+	if s := e.String(); s != "(((a + b) * 2) > 10)" {
+		t.Errorf("unexpected string %s", s)
+	}
+	out := df.Filter(e)
+	assertColumn(t, "a", out.Column("a"), []any{int64(2), int64(4)})
+
+	out = df.Filter(dataframe.Col("b").Gt(dataframe.Lit(100)).Or(dataframe.Col("x").Lt(dataframe.Lit(1))))
+	assertColumn(t, "a", out.Column("a"), []any{int64(1)})
+
+	// false and null is false, so the null in b does not keep row 3
+	out = df.Filter(dataframe.Col("b").Gt(dataframe.Lit(0)).And(dataframe.Col("name").Ne(dataframe.Lit("cy"))).Not())
+	assertColumn(t, "a", out.Column("a"), []any{int64(3)})
+
+	out = df.Filter(dataframe.Col("b").IsNull().Or(dataframe.Col("name").Eq(dataframe.Lit("ann"))))
+	assertColumn(t, "a", out.Column("a"), []any{int64(1), int64(3)})
+
+	ts := dataframe.New()
+	ts.AddColumn("at", dataframe.NewTime(
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	))
+	if n := ts.Filter(dataframe.Col("at").Ge(dataframe.Lit(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))).Len(); n != 1 {
+		t.Errorf("expected 1 row, got %d", n)
+	}
+}
+
+func TestExprCheck(t *testing.T) {
+	df := exprFrame()
+
+	for _, c := range []struct {
+		expr   dataframe.Expr
+		target error
+	}{
+		{dataframe.Col("missing").Add(dataframe.Lit(1)), dataframe.ErrColumnNotFound},
+		{dataframe.Col("name").Add(dataframe.Lit(1)), dataframe.ErrTypeMismatch},
+		{dataframe.Col("name").Lt(dataframe.Col("a")), dataframe.ErrTypeMismatch},
+		{dataframe.Col("a").And(dataframe.Lit(true)), dataframe.ErrTypeMismatch},
+		{dataframe.Lit([]int{1}), dataframe.ErrTypeMismatch},
+	} {
+		if err := c.expr.Check(df); !errors.Is(err, c.target) {
+			t.Errorf("%s: expected %v, got %v", c.expr, c.target, err)
+		}
+	}
+
+	if _, err := df.TryFilter(dataframe.Col("a").Add(dataframe.Lit(1))); !errors.Is(err, dataframe.ErrTypeMismatch) {
+		t.Errorf("expected a non-bool filter to fail, got %v", err)
+	}
+	if err := df.TryWithColumn("c", dataframe.Col("nope")); !errors.Is(err, dataframe.ErrColumnNotFound) {
+		t.Errorf("expected ErrColumnNotFound, got %v", err)
+	}
+}
+
+func TestExprAgg(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("shop", dataframe.NewString("a", "b", "a"))
+	df.AddColumn("price", dataframe.NewFloat(2, 3, 4))
+	df.AddColumn("qty", dataframe.NewInt(1, 2, 3))
+
+	revenue := dataframe.Col("price").Mul(dataframe.Col("qty"))
+	out := df.GroupBy("shop").Agg(
+		dataframe.Aggregation{Expr: revenue, Func: dataframe.Sum, Name: "revenue"},
+		dataframe.Aggregation{Expr: dataframe.Col("qty").Add(dataframe.Lit(1)), Func: dataframe.Max},
+	)
+
+	assertColumn(t, "revenue", out.Column("revenue"), []any{14.0, 6.0})
+	assertColumn(t, "(qty + 1)", out.Column("(qty + 1)"), []any{int64(4), int64(3)})
+}
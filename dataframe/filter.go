@@ -1,6 +1,13 @@
 package dataframe
 
-import "time"
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
 
 type IApplicable interface {
 	Apply(df *DataFrame)
@@ -61,6 +68,9 @@ type EQ struct {
 func (eq *EQ) check(df *DataFrame, i int) bool {
 	idx := df.index[eq.Column]
 	col := df.data[idx]
+	if col.IsNull(i) {
+		return false
+	}
 
 	switch col.(type) {
 	case *Int:
@@ -75,7 +85,7 @@ func (eq *EQ) check(df *DataFrame, i int) bool {
 		x := col.Index(i).(bool)
 		y := eq.Value.(bool)
 		return x == y
-	case *String:
+	case *String, *Categorical:
 		x := col.Index(i).(string)
 		y := eq.Value.(string)
 		return x == y
@@ -83,8 +93,10 @@ func (eq *EQ) check(df *DataFrame, i int) bool {
 		x := col.Index(i).(time.Time)
 		y := eq.Value.(time.Time)
 		return x == y
+	case *Int32, *Uint64, *Float32, *Decimal:
+		return compareNumber(col, i, eq.Value) == 0
 	default:
-		return false
+		return reflect.DeepEqual(col.Index(i), eq.Value)
 	}
 }
 
@@ -97,6 +109,9 @@ type NEQ struct {
 func (neq *NEQ) check(df *DataFrame, i int) bool {
 	idx := df.index[neq.Column]
 	col := df.data[idx]
+	if col.IsNull(i) {
+		return false
+	}
 
 	switch col.(type) {
 	case *Int:
@@ -111,7 +126,7 @@ func (neq *NEQ) check(df *DataFrame, i int) bool {
 		x := col.Index(i).(bool)
 		y := neq.Value.(bool)
 		return x != y
-	case *String:
+	case *String, *Categorical:
 		x := col.Index(i).(string)
 		y := neq.Value.(string)
 		return x != y
@@ -119,8 +134,10 @@ func (neq *NEQ) check(df *DataFrame, i int) bool {
 		x := col.Index(i).(time.Time)
 		y := neq.Value.(time.Time)
 		return x != y
+	case *Int32, *Uint64, *Float32, *Decimal:
+		return compareNumber(col, i, neq.Value) != 0
 	default:
-		return false
+		return !reflect.DeepEqual(col.Index(i), neq.Value)
 	}
 }
 
@@ -133,6 +150,9 @@ type LT struct {
 func (lt *LT) check(df *DataFrame, i int) bool {
 	idx := df.index[lt.Column]
 	col := df.data[idx]
+	if col.IsNull(i) {
+		return false
+	}
 
 	switch col.(type) {
 	case *Int:
@@ -151,10 +171,14 @@ func (lt *LT) check(df *DataFrame, i int) bool {
 		x := col.Index(i).(string)
 		y := lt.Value.(string)
 		return x < y
+	case *Categorical:
+		return col.(*Categorical).compare(i, lt.Value.(string)) < 0
 	case *Time:
 		x := col.Index(i).(time.Time)
 		y := lt.Value.(time.Time)
 		return x.Before(y)
+	case *Int32, *Uint64, *Float32, *Decimal:
+		return compareNumber(col, i, lt.Value) < 0
 	default:
 		return false
 	}
@@ -169,6 +193,9 @@ type GT struct {
 func (gt *GT) check(df *DataFrame, i int) bool {
 	idx := df.index[gt.Column]
 	col := df.data[idx]
+	if col.IsNull(i) {
+		return false
+	}
 
 	switch col.(type) {
 	case *Int:
@@ -187,10 +214,14 @@ func (gt *GT) check(df *DataFrame, i int) bool {
 		x := col.Index(i).(string)
 		y := gt.Value.(string)
 		return x > y
+	case *Categorical:
+		return col.(*Categorical).compare(i, gt.Value.(string)) > 0
 	case *Time:
 		x := col.Index(i).(time.Time)
 		y := gt.Value.(time.Time)
 		return x.After(y)
+	case *Int32, *Uint64, *Float32, *Decimal:
+		return compareNumber(col, i, gt.Value) > 0
 	default:
 		return false
 	}
@@ -205,6 +236,9 @@ type LTE struct {
 func (lte *LTE) check(df *DataFrame, i int) bool {
 	idx := df.index[lte.Column]
 	col := df.data[idx]
+	if col.IsNull(i) {
+		return false
+	}
 
 	switch col.(type) {
 	case *Int:
@@ -221,10 +255,14 @@ func (lte *LTE) check(df *DataFrame, i int) bool {
 		x := col.Index(i).(string)
 		y := lte.Value.(string)
 		return x <= y
+	case *Categorical:
+		return col.(*Categorical).compare(i, lte.Value.(string)) <= 0
 	case *Time:
 		x := col.Index(i).(time.Time)
 		y := lte.Value.(time.Time)
 		return x.Before(y) || x == y
+	case *Int32, *Uint64, *Float32, *Decimal:
+		return compareNumber(col, i, lte.Value) <= 0
 	default:
 		return false
 	}
@@ -239,6 +277,9 @@ type GTE struct {
 func (gte *GTE) check(df *DataFrame, i int) bool {
 	idx := df.index[gte.Column]
 	col := df.data[idx]
+	if col.IsNull(i) {
+		return false
+	}
 
 	switch col.(type) {
 	case *Int:
@@ -255,10 +296,136 @@ func (gte *GTE) check(df *DataFrame, i int) bool {
 		x := col.Index(i).(string)
 		y := gte.Value.(string)
 		return x >= y
+	case *Categorical:
+		return col.(*Categorical).compare(i, gte.Value.(string)) >= 0
 	case *Time:
 		x := col.Index(i).(time.Time)
 		y := gte.Value.(time.Time)
 		return x.After(y) || x == y
+	case *Int32, *Uint64, *Float32, *Decimal:
+		return compareNumber(col, i, gte.Value) >= 0
+	default:
+		return false
+	}
+}
+
+type IsNull struct {
+	Column string
+}
+
+func (n *IsNull) check(df *DataFrame, i int) bool {
+	idx := df.index[n.Column]
+	return df.data[idx].IsNull(i)
+}
+
+type NotNull struct {
+	Column string
+}
+
+func (n *NotNull) check(df *DataFrame, i int) bool {
+	idx := df.index[n.Column]
+	return !df.data[idx].IsNull(i)
+}
+
+// Like matches String or Categorical values against an SQL LIKE pattern,
+// where % matches any run of characters and _ matches exactly one.
+type Like struct {
+	Column  string
+	Pattern string
+}
+
+func (like *Like) check(df *DataFrame, i int) bool {
+	return matchString(df, like.Column, i, like.compile(), nil)
+}
+
+func (like *Like) compile() *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?s)^")
+	for _, r := range like.Pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+type Regex struct {
+	Column  string
+	Pattern *regexp.Regexp
+}
+
+func (re *Regex) check(df *DataFrame, i int) bool {
+	return matchString(df, re.Column, i, re.Pattern, nil)
+}
+
+// matcher is a Like or Regex prepared for one call of Filtered, with the
+// pattern compiled once and Categorical levels matched once each.
+type matcher struct {
+	column string
+	re     *regexp.Regexp
+	levels map[string]bool
+}
+
+func (m *matcher) check(df *DataFrame, i int) bool {
+	return matchString(df, m.column, i, m.re, &m.levels)
+}
+
+// prepare returns f with every Like and Regex in it replaced by a matcher,
+// leaving the filters the caller holds untouched so they can be reused and
+// shared between goroutines.
+func prepare(f filter) filter {
+	switch c := f.(type) {
+	case *And:
+		return AND(prepareAll(c.filters)...)
+	case *Or:
+		return OR(prepareAll(c.filters)...)
+	case *Like:
+		return &matcher{column: c.Column, re: c.compile()}
+	case *Regex:
+		return &matcher{column: c.Column, re: c.Pattern}
+	default:
+		return f
+	}
+}
+
+func prepareAll(filters []filter) []filter {
+	out := make([]filter, len(filters))
+	for k, f := range filters {
+		out[k] = prepare(f)
+	}
+	return out
+}
+
+// matchString matches the value at row i against re. Categorical levels are
+// matched once each and remembered in levels when it is not nil.
+func matchString(df *DataFrame, column string, i int, re *regexp.Regexp, levels *map[string]bool) bool {
+	switch col := df.data[df.index[column]].(type) {
+	case *String:
+		return !col.IsNull(i) && re.MatchString(col.data[i])
+	case *Categorical:
+		if col.IsNull(i) {
+			return false
+		}
+
+		level := col.levels[col.data[i]]
+		if levels == nil {
+			return re.MatchString(level)
+		}
+		if *levels == nil {
+			*levels = map[string]bool{}
+		}
+		ok, seen := (*levels)[level]
+		if !seen {
+			ok = re.MatchString(level)
+			(*levels)[level] = ok
+		}
+		return ok
 	default:
 		return false
 	}
@@ -268,3 +435,22 @@ type IN[T any] struct {
 	Column string
 	Values []T
 }
+
+// compareNumber orders the value at row i of an Int32, Uint64, Float32 or
+// Decimal column against value, converted to the type of the column.
+func compareNumber(col IColumn, i int, value any) int {
+	value = coerce(col, value)
+
+	switch c := col.(type) {
+	case *Int32:
+		return cmp.Compare(c.data[i], value.(int32))
+	case *Uint64:
+		return cmp.Compare(c.data[i], value.(uint64))
+	case *Float32:
+		return cmp.Compare(c.data[i], value.(float32))
+	case *Decimal:
+		return c.fixed(c.data[i]).Cmp(value.(Fixed))
+	default:
+		panic(fmt.Errorf("%w: cannot compare %T", ErrTypeMismatch, col))
+	}
+}
@@ -1,119 +1,137 @@
 package dataframe
 
-import (
-	"slices"
-	"sort"
-)
+import "fmt"
 
 type Float struct {
-	data []float64
+	Column[float64]
 }
 
 func NewFloat(data ...float64) *Float {
-	return &Float{
-		data: data,
-	}
+	return &Float{Column[float64]{data: data}}
 }
 
 func (col *Float) New() IColumn {
 	return &Float{}
 }
 
-func (col *Float) Len() int {
-	return len(col.data)
-}
-
-func (col *Float) Data() []float64 {
-	return slices.Clone(col.data)
-}
-
-func (col *Float) Extend(length int) {
-	diff := length - len(col.data)
-	if diff > 0 {
-		col.data = append(col.data, make([]float64, diff)...)
-	}
-}
-
-func (col *Float) Index(idx int) any {
-	return col.data[idx]
-}
-
 func (col *Float) Clone() IColumn {
-	newData := make([]float64, len(col.data))
-	copy(newData, col.data)
-	return &Float{data: newData}
-}
-
-func (col *Float) DeleteRow(index int) {
-	col.data = append(col.data[:index], col.data[index+1:]...)
-}
-
-func (col *Float) Set(index int, value any) {
-	col.data[index] = value.(float64)
+	return &Float{col.clone()}
 }
 
-func (col *Float) Append(value float64) {
-	col.data = append(col.data, value)
-}
-
-func (col *Float) Head() (float64, bool) {
-	if len(col.data) == 0 {
-		return 0, false
-	}
-
-	return col.data[0], true
-}
-
-func (col *Float) Tail() []float64 {
-	if len(col.data) <= 1 {
-		return nil
-	}
-	return col.data[1:]
-}
-
-func (col *Float) Last() (float64, bool) {
-	if len(col.data) == 0 {
-		return 0, false
-	}
-	return col.data[len(col.data)-1], true
-}
-
-func (col *Float) Slice(start, end int) []float64 {
-	return slices.Clone(col.data[start:end])
+func (col *Float) Take(indices []int) IColumn {
+	return &Float{col.take(indices)}
 }
 
 func (col *Float) SortBy(asc bool) {
-	sort.Slice(col.data, func(i, j int) bool {
+	sortValues(col.data, &col.nulls, func(a, b float64) bool {
 		if asc {
-			return col.data[i] < col.data[j]
+			return a < b
 		}
-		return col.data[i] > col.data[j]
+		return a > b
 	})
 }
 
 func (col *Float) Add(other *Float) {
+	sameLength(col, other)
 	for i := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if other.nulls.isNull(i) {
+			col.Set(i, nil)
+			continue
+		}
 		col.data[i] += other.data[i]
 	}
 }
 
 func (col *Float) Sub(other *Float) {
+	sameLength(col, other)
 	for i := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if other.nulls.isNull(i) {
+			col.Set(i, nil)
+			continue
+		}
 		col.data[i] -= other.data[i]
 	}
 }
 
 func (col *Float) Mul(other *Float) {
+	sameLength(col, other)
 	for i := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if other.nulls.isNull(i) {
+			col.Set(i, nil)
+			continue
+		}
 		col.data[i] *= other.data[i]
 	}
 }
 
+// Div panics with ErrDivisionByZero before changing any value if a non-null
+// row is divided by zero.
 func (col *Float) Div(other *Float) {
+	sameLength(col, other)
+	for i, v := range other.data {
+		if v == 0 && !other.nulls.isNull(i) && !col.nulls.isNull(i) {
+			panic(fmt.Errorf("%w: row %d", ErrDivisionByZero, i))
+		}
+	}
 	for i := range col.data {
-		if other.data[i] == 0 {
-			panic("division by zero")
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if other.nulls.isNull(i) {
+			col.Set(i, nil)
+			continue
 		}
 		col.data[i] /= other.data[i]
 	}
 }
+
+func (col *Float) Shift(n int) *Float {
+	return &Float{shiftValues(&col.Column, n)}
+}
+
+func (col *Float) Diff(n int) *Float {
+	return &Float{diffValues(&col.Column, n)}
+}
+
+func (col *Float) PctChange(n int) *Float {
+	return &Float{pctChange(&col.Column, n)}
+}
+
+func (col *Float) CumSum() *Float {
+	return &Float{scanValues(&col.Column, func(acc, v float64) float64 { return acc + v })}
+}
+
+func (col *Float) CumProd() *Float {
+	return &Float{scanValues(&col.Column, func(acc, v float64) float64 { return acc * v })}
+}
+
+func (col *Float) CumMax() *Float {
+	return &Float{scanValues(&col.Column, func(acc, v float64) float64 {
+		if v > acc {
+			return v
+		}
+		return acc
+	})}
+}
+
+func (col *Float) CumMin() *Float {
+	return &Float{scanValues(&col.Column, func(acc, v float64) float64 {
+		if v < acc {
+			return v
+		}
+		return acc
+	})}
+}
+
+func (col *Float) Rank(method RankMethod) *Float {
+	return rankValues(col.data, &col.nulls, method)
+}
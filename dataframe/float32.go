@@ -0,0 +1,30 @@
+package dataframe
+
+type Float32 struct {
+	Column[float32]
+}
+
+func NewFloat32(data ...float32) *Float32 {
+	return &Float32{Column[float32]{data: data}}
+}
+
+func (col *Float32) New() IColumn {
+	return &Float32{}
+}
+
+func (col *Float32) Clone() IColumn {
+	return &Float32{col.clone()}
+}
+
+func (col *Float32) Take(indices []int) IColumn {
+	return &Float32{col.take(indices)}
+}
+
+func (col *Float32) SortBy(asc bool) {
+	sortValues(col.data, &col.nulls, func(a, b float32) bool {
+		if asc {
+			return a < b
+		}
+		return a > b
+	})
+}
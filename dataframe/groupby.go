@@ -0,0 +1,432 @@
+package dataframe
+
+import (
+	"fmt"
+	"time"
+)
+
+type Reducer func(col IColumn) any
+
+// Aggregation reduces a column, or the values of Expr when it is set, named
+// Name or else after the column or expression.
+type Aggregation struct {
+	Column string
+	Expr   Expr
+	Func   Reducer
+	Name   string
+}
+
+// GroupBy holds the row indices of each distinct key, in the order the keys
+// first appear in the frame. Null keys form their own group.
+type GroupBy struct {
+	df     *DataFrame
+	keys   []string
+	groups [][]int
+}
+
+func (df *DataFrame) GroupBy(columns ...string) *GroupBy {
+	cols := make([]IColumn, len(columns))
+	for i, name := range columns {
+		cols[i] = df.Column(name)
+	}
+
+	return &GroupBy{
+		df:     df,
+		keys:   append([]string{}, columns...),
+		groups: groupRows(cols, df.rowCount),
+	}
+}
+
+func (g *GroupBy) Len() int {
+	return len(g.groups)
+}
+
+func (g *GroupBy) Agg(aggs ...Aggregation) *DataFrame {
+	first := make([]int, len(g.groups))
+	for i, rows := range g.groups {
+		first[i] = rows[0]
+	}
+
+	out := New()
+	for _, key := range g.keys {
+		out.AddColumn(key, g.df.Column(key).Take(first))
+	}
+
+	aggregate(out, g.df, g.groups, aggs)
+	return out
+}
+
+// aggregate adds one column per aggregation to out, reducing the rows of
+// each group. Empty groups produce a null.
+func aggregate(out, df *DataFrame, groups [][]int, aggs []Aggregation) {
+	for _, agg := range aggs {
+		var src IColumn
+		if agg.Expr.node != nil {
+			src = df.Eval(agg.Expr)
+		} else {
+			src = df.Column(agg.Column)
+		}
+
+		values := make([]any, len(groups))
+		for i, rows := range groups {
+			if len(rows) > 0 {
+				values[i] = agg.Func(src.Take(rows))
+			}
+		}
+
+		name := agg.Name
+		if name == "" {
+			name = agg.Column
+		}
+		if name == "" {
+			name = agg.Expr.String()
+		}
+		if _, ok := out.index[name]; ok {
+			panic(fmt.Errorf("%w: duplicate column %q", ErrInvalidArgument, name))
+		}
+
+		out.AddColumn(name, valuesColumn(values, src))
+	}
+}
+
+// Transform applies f to the rows of every group and places the results back
+// at the positions of those rows, so f can be any column to column function
+// such as CumSum or Shift.
+func (g *GroupBy) Transform(column string, f func(col IColumn) IColumn) IColumn {
+	src := g.df.Column(column)
+
+	var out IColumn
+	for _, rows := range g.groups {
+		res := f(src.Take(rows))
+		if out == nil {
+			out = res.New()
+			out.Extend(g.df.rowCount)
+		}
+
+		for k, row := range rows {
+			out.Set(row, res.Index(k))
+		}
+	}
+
+	if out == nil {
+		out = src.New()
+	}
+
+	return out
+}
+
+func Sum(col IColumn) any {
+	switch c := col.(type) {
+	case *Int:
+		return c.Sum()
+	case *Float:
+		return c.Sum()
+	case *Int32:
+		return c.Sum()
+	case *Uint64:
+		return c.Sum()
+	case *Float32:
+		return c.Sum()
+	case *Decimal:
+		return c.Sum()
+	default:
+		panic(fmt.Errorf("%w: sum not supported for %T", ErrTypeMismatch, col))
+	}
+}
+
+func Mean(col IColumn) any {
+	if col.Len() == col.NullCount() {
+		return nil
+	}
+
+	switch c := col.(type) {
+	case *Int:
+		return c.Mean()
+	case *Float:
+		return c.Mean()
+	case *Int32:
+		return c.Mean()
+	case *Uint64:
+		return c.Mean()
+	case *Float32:
+		return c.Mean()
+	case *Decimal:
+		return c.Mean()
+	default:
+		panic(fmt.Errorf("%w: mean not supported for %T", ErrTypeMismatch, col))
+	}
+}
+
+func Median(col IColumn) any {
+	if col.Len() == col.NullCount() {
+		return nil
+	}
+
+	switch c := col.(type) {
+	case *Int:
+		return c.Median()
+	case *Float:
+		return c.Median()
+	case *Int32:
+		return c.Median()
+	case *Uint64:
+		return c.Median()
+	case *Float32:
+		return c.Median()
+	case *Decimal:
+		return c.Median()
+	default:
+		panic(fmt.Errorf("%w: median not supported for %T", ErrTypeMismatch, col))
+	}
+}
+
+func Std(col IColumn) any {
+	if col.Len()-col.NullCount() < 2 {
+		return nil
+	}
+
+	switch c := col.(type) {
+	case *Int:
+		return c.Std()
+	case *Float:
+		return c.Std()
+	case *Int32:
+		return c.Std()
+	case *Uint64:
+		return c.Std()
+	case *Float32:
+		return c.Std()
+	case *Decimal:
+		return c.Std()
+	default:
+		panic(fmt.Errorf("%w: std not supported for %T", ErrTypeMismatch, col))
+	}
+}
+
+func Min(col IColumn) any {
+	if col.Len() == col.NullCount() {
+		return nil
+	}
+
+	switch c := col.(type) {
+	case *Int:
+		return c.Min()
+	case *Float:
+		return c.Min()
+	case *Int32:
+		return c.Min()
+	case *Uint64:
+		return c.Min()
+	case *Float32:
+		return c.Min()
+	case *Decimal:
+		return c.Min()
+	case *String:
+		return c.Min()
+	case *Time:
+		return c.Min()
+	case *Categorical:
+		return c.Min()
+	default:
+		panic(fmt.Errorf("%w: min not supported for %T", ErrTypeMismatch, col))
+	}
+}
+
+func Max(col IColumn) any {
+	if col.Len() == col.NullCount() {
+		return nil
+	}
+
+	switch c := col.(type) {
+	case *Int:
+		return c.Max()
+	case *Float:
+		return c.Max()
+	case *Int32:
+		return c.Max()
+	case *Uint64:
+		return c.Max()
+	case *Float32:
+		return c.Max()
+	case *Decimal:
+		return c.Max()
+	case *String:
+		return c.Max()
+	case *Time:
+		return c.Max()
+	case *Categorical:
+		return c.Max()
+	default:
+		panic(fmt.Errorf("%w: max not supported for %T", ErrTypeMismatch, col))
+	}
+}
+
+func Count(col IColumn) any {
+	return int64(col.Len() - col.NullCount())
+}
+
+func First(col IColumn) any {
+	for i := 0; i < col.Len(); i++ {
+		if !col.IsNull(i) {
+			return col.Index(i)
+		}
+	}
+
+	return nil
+}
+
+func Last(col IColumn) any {
+	for i := col.Len() - 1; i >= 0; i-- {
+		if !col.IsNull(i) {
+			return col.Index(i)
+		}
+	}
+
+	return nil
+}
+
+func groupRows(cols []IColumn, n int) [][]int {
+	codes := make([]int, n)
+
+	for _, col := range cols {
+		colCodes := factorize(col)
+		seen := map[[2]int]int{}
+
+		for i := range codes {
+			key := [2]int{codes[i], colCodes[i]}
+			code, ok := seen[key]
+			if !ok {
+				code = len(seen)
+				seen[key] = code
+			}
+			codes[i] = code
+		}
+	}
+
+	groups := [][]int{}
+	for i, code := range codes {
+		if code == len(groups) {
+			groups = append(groups, []int{})
+		}
+		groups[code] = append(groups[code], i)
+	}
+
+	return groups
+}
+
+func factorize(col IColumn) []int {
+	codes := make([]int, col.Len())
+
+	switch c := col.(type) {
+	case *Int:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *Float:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *Bool:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *String:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *Categorical:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *Int32:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *Uint64:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *Float32:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *Decimal:
+		factorizeValues(c.data, &c.nulls, codes)
+	case *Time:
+		keys := make([]timeKey, len(c.data))
+		for i, t := range c.data {
+			keys[i] = timeKey{t.Unix(), t.Nanosecond()}
+		}
+		factorizeValues(keys, &c.nulls, codes)
+	case *List, *Struct:
+		// their values are slices and maps, which cannot be map keys
+		panic(fmt.Errorf("%w: cannot group or match %T values", ErrTypeMismatch, col))
+	default:
+		seen := map[any]int{}
+		for i := range codes {
+			v := col.Index(i)
+			code, ok := seen[v]
+			if !ok {
+				code = len(seen)
+				seen[v] = code
+			}
+			codes[i] = code
+		}
+	}
+
+	return codes
+}
+
+type timeKey struct {
+	sec  int64
+	nsec int
+}
+
+func factorizeValues[T comparable](data []T, nulls *bitmap, codes []int) {
+	seen := map[T]int{}
+	nullCode := -1
+	n := 0
+
+	for i, v := range data {
+		if nulls.isNull(i) {
+			if nullCode < 0 {
+				nullCode = n
+				n++
+			}
+			codes[i] = nullCode
+			continue
+		}
+
+		code, ok := seen[v]
+		if !ok {
+			code = n
+			seen[v] = code
+			n++
+		}
+		codes[i] = code
+	}
+}
+
+func valuesColumn(values []any, fallback IColumn) IColumn {
+	var col IColumn
+
+	for _, v := range values {
+		switch x := convert([]any{v})[0].(type) {
+		case int64:
+			col = NewInt()
+		case uint64:
+			col = NewUint64()
+		case Fixed:
+			col = NewDecimal(x.Scale)
+		case float64:
+			col = NewFloat()
+		case bool:
+			col = NewBool()
+		case string:
+			col = NewString()
+		case time.Time:
+			col = NewTime()
+		}
+
+		if col != nil {
+			break
+		}
+	}
+
+	if col == nil {
+		col = fallback.New()
+	}
+
+	for i, v := range values {
+		col.Extend(i + 1)
+		if v != nil {
+			col.Set(i, coerce(col, v))
+		}
+	}
+
+	return col
+}
@@ -0,0 +1,97 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newSales() *dataframe.DataFrame {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	df := dataframe.New()
+	df.AddColumn("region", dataframe.NewString("north", "south", "north", "north", "south"))
+	df.AddColumn("product", dataframe.NewString("a", "a", "b", "a", "a"))
+	df.AddColumn("promo", dataframe.NewBool(true, false, true, false, false))
+	df.AddColumn("day", dataframe.NewTime(day, day, day.Add(24*time.Hour), day, day))
+	df.AddColumn("units", dataframe.NewInt(1, 2, 3, 5, 4))
+	df.AddColumn("price", dataframe.NewFloat(1.5, 2.5, 3.5))
+	return df
+}
+
+func TestGroupBy(t *testing.T) {
+	df := newSales()
+
+	res := df.GroupBy("region", "product").Agg(
+		dataframe.Aggregation{Column: "units", Func: dataframe.Sum, Name: "total"},
+		dataframe.Aggregation{Column: "units", Func: dataframe.Mean, Name: "mean"},
+		dataframe.Aggregation{Column: "units", Func: dataframe.Std, Name: "std"},
+		dataframe.Aggregation{Column: "price", Func: dataframe.Count, Name: "priced"},
+		dataframe.Aggregation{Column: "price", Func: dataframe.Max},
+		dataframe.Aggregation{Column: "day", Func: dataframe.Last},
+		dataframe.Aggregation{Column: "units", Func: func(col dataframe.IColumn) any {
+			return col.Len() * 10
+		}, Name: "custom"},
+	)
+
+	if h := strings.Join(res.Headers(), ","); h != "region,product,total,mean,std,priced,price,day,custom" {
+		t.Fatalf("unexpected headers %s", h)
+	}
+	if res.Len() != 3 {
+		t.Fatalf("expected 3 groups, got %d", res.Len())
+	}
+
+	want := [][]any{
+		{"north", "a", int64(6), 3.0, math.Sqrt(8), int64(1), 1.5, nil, int64(20)},
+		{"south", "a", int64(6), 3.0, math.Sqrt(2), int64(1), 2.5, nil, int64(20)},
+		{"north", "b", int64(3), 3.0, nil, int64(1), 3.5, nil, int64(10)},
+	}
+
+	for i, row := range want {
+		got := res.Row(i)
+		for j, v := range row {
+			if v == nil {
+				continue
+			}
+			if got[j] != v {
+				t.Errorf("row %d col %s: expected %v, got %v", i, res.Headers()[j], v, got[j])
+			}
+		}
+	}
+
+	if !res.Column("std").IsNull(2) {
+		t.Error("expected null std for single row group")
+	}
+	if res.Column("price").IsNull(0) {
+		t.Error("expected max to skip nulls")
+	}
+}
+
+func TestGroupByKeyTypes(t *testing.T) {
+	df := newSales()
+
+	byBool := df.GroupBy("promo").Agg(
+		dataframe.Aggregation{Column: "units", Func: dataframe.Sum},
+	)
+	if byBool.Len() != 2 || byBool.Column("units").Index(0) != int64(4) {
+		t.Errorf("unexpected bool grouping %v", byBool.Row(0))
+	}
+
+	byTime := df.GroupBy("day").Agg(
+		dataframe.Aggregation{Column: "units", Func: dataframe.Median},
+		dataframe.Aggregation{Column: "region", Func: dataframe.First},
+	)
+	if byTime.Len() != 2 || byTime.Column("units").Index(0) != 3.0 {
+		t.Errorf("unexpected time grouping %v", byTime.Row(0))
+	}
+
+	df.AppendRow(nil, "a", false, nil, 7)
+	withNull := df.GroupBy("region").Agg(
+		dataframe.Aggregation{Column: "units", Func: dataframe.Min},
+	)
+	if withNull.Len() != 3 || !withNull.Column("region").IsNull(2) {
+		t.Errorf("expected null keys to form a group, got %d groups", withNull.Len())
+	}
+}
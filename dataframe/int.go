@@ -1,128 +1,137 @@
 package dataframe
 
-import (
-	"slices"
-	"sort"
-)
-
-type IColumn interface {
-	Len() int
-	Extend(int)
-	Index(int) any
-	New() IColumn
-	Clone() IColumn
-	DeleteRow(index int)
-	Set(index int, value any)
-}
+import "fmt"
 
 type Int struct {
-	data []int64
+	Column[int64]
 }
 
 func NewInt(data ...int64) *Int {
-	return &Int{
-		data: data,
-	}
+	return &Int{Column[int64]{data: data}}
 }
 
 func (col *Int) New() IColumn {
 	return &Int{}
 }
 
-func (col *Int) Len() int {
-	return len(col.data)
-}
-
-func (col *Int) Data() []int64 {
-	return slices.Clone(col.data)
-}
-
-func (col *Int) Extend(length int) {
-	diff := length - len(col.data)
-	if diff > 0 {
-		col.data = append(col.data, make([]int64, diff)...)
-	}
-}
-
-func (col *Int) Index(idx int) any {
-	return col.data[idx]
-}
-
 func (col *Int) Clone() IColumn {
-	newData := make([]int64, len(col.data))
-	copy(newData, col.data)
-	return &Int{data: newData}
-}
-
-func (col *Int) DeleteRow(index int) {
-	col.data = append(col.data[:index], col.data[index+1:]...)
-}
-
-func (col *Int) Set(index int, value any) {
-	col.data[index] = value.(int64)
-}
-
-func (col *Int) Append(value int64) {
-	col.data = append(col.data, value)
-}
-
-func (col *Int) Head() (int64, bool) {
-	if len(col.data) == 0 {
-		return 0, false
-	}
-	return col.data[0], true
-}
-
-func (col *Int) Tail() []int64 {
-	if len(col.data) <= 1 {
-		return nil
-	}
-	return col.data[1:]
-}
-
-func (col *Int) Last() (int64, bool) {
-	if len(col.data) == 0 {
-		return 0, false
-	}
-	return col.data[len(col.data)-1], true
+	return &Int{col.clone()}
 }
 
-func (col *Int) Slice(start, end int) []int64 {
-	return slices.Clone(col.data[start:end])
+func (col *Int) Take(indices []int) IColumn {
+	return &Int{col.take(indices)}
 }
 
 func (col *Int) SortBy(asc bool) {
-	sort.Slice(col.data, func(i, j int) bool {
+	sortValues(col.data, &col.nulls, func(a, b int64) bool {
 		if asc {
-			return col.data[i] < col.data[j]
+			return a < b
 		}
-		return col.data[i] > col.data[j]
+		return a > b
 	})
 }
 
 func (col *Int) Add(other *Int) {
+	sameLength(col, other)
 	for i := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if other.nulls.isNull(i) {
+			col.Set(i, nil)
+			continue
+		}
 		col.data[i] += other.data[i]
 	}
 }
 
 func (col *Int) Sub(other *Int) {
+	sameLength(col, other)
 	for i := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if other.nulls.isNull(i) {
+			col.Set(i, nil)
+			continue
+		}
 		col.data[i] -= other.data[i]
 	}
 }
 
 func (col *Int) Mul(other *Int) {
+	sameLength(col, other)
 	for i := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if other.nulls.isNull(i) {
+			col.Set(i, nil)
+			continue
+		}
 		col.data[i] *= other.data[i]
 	}
 }
 
+// Div panics with ErrDivisionByZero before changing any value if a non-null
+// row is divided by zero.
 func (col *Int) Div(other *Int) {
+	sameLength(col, other)
+	for i, v := range other.data {
+		if v == 0 && !other.nulls.isNull(i) && !col.nulls.isNull(i) {
+			panic(fmt.Errorf("%w: row %d", ErrDivisionByZero, i))
+		}
+	}
 	for i := range col.data {
-		if other.data[i] == 0 {
-			panic("division by zero")
+		if col.nulls.isNull(i) {
+			continue
+		}
+		if other.nulls.isNull(i) {
+			col.Set(i, nil)
+			continue
 		}
 		col.data[i] /= other.data[i]
 	}
 }
+
+func (col *Int) Shift(n int) *Int {
+	return &Int{shiftValues(&col.Column, n)}
+}
+
+func (col *Int) Diff(n int) *Int {
+	return &Int{diffValues(&col.Column, n)}
+}
+
+func (col *Int) PctChange(n int) *Float {
+	return &Float{pctChange(&col.Column, n)}
+}
+
+func (col *Int) CumSum() *Int {
+	return &Int{scanValues(&col.Column, func(acc, v int64) int64 { return acc + v })}
+}
+
+func (col *Int) CumProd() *Int {
+	return &Int{scanValues(&col.Column, func(acc, v int64) int64 { return acc * v })}
+}
+
+func (col *Int) CumMax() *Int {
+	return &Int{scanValues(&col.Column, func(acc, v int64) int64 {
+		if v > acc {
+			return v
+		}
+		return acc
+	})}
+}
+
+func (col *Int) CumMin() *Int {
+	return &Int{scanValues(&col.Column, func(acc, v int64) int64 {
+		if v < acc {
+			return v
+		}
+		return acc
+	})}
+}
+
+func (col *Int) Rank(method RankMethod) *Float {
+	return rankValues(col.data, &col.nulls, method)
+}
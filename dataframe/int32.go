@@ -0,0 +1,30 @@
+package dataframe
+
+type Int32 struct {
+	Column[int32]
+}
+
+func NewInt32(data ...int32) *Int32 {
+	return &Int32{Column[int32]{data: data}}
+}
+
+func (col *Int32) New() IColumn {
+	return &Int32{}
+}
+
+func (col *Int32) Clone() IColumn {
+	return &Int32{col.clone()}
+}
+
+func (col *Int32) Take(indices []int) IColumn {
+	return &Int32{col.take(indices)}
+}
+
+func (col *Int32) SortBy(asc bool) {
+	sortValues(col.data, &col.nulls, func(a, b int32) bool {
+		if asc {
+			return a < b
+		}
+		return a > b
+	})
+}
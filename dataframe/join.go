@@ -0,0 +1,355 @@
+package dataframe
+
+import (
+	"fmt"
+	"time"
+)
+
+type JoinHow int
+
+const (
+	InnerJoin JoinHow = iota
+	LeftJoin
+	RightJoin
+	OuterJoin
+	SemiJoin
+	AntiJoin
+)
+
+func Join(left, right *DataFrame, on []string, how JoinHow) *DataFrame {
+	return JoinSuffixes(left, right, on, how, "_left", "_right")
+}
+
+// JoinSuffixes joins two frames on the given key columns. Null keys never
+// match, and rows without a match on the other side are filled with nulls.
+func JoinSuffixes(
+	left, right *DataFrame,
+	on []string,
+	how JoinHow,
+	leftSuffix, rightSuffix string,
+) *DataFrame {
+	lkeys := make([]IColumn, len(on))
+	rkeys := make([]IColumn, len(on))
+	protos := make([]IColumn, len(on))
+	for i, name := range on {
+		lkeys[i] = left.Column(name)
+		rkeys[i] = right.Column(name)
+		protos[i] = keyColumn(lkeys[i], rkeys[i])
+	}
+
+	lcodes, rcodes := joinCodes(lkeys, rkeys, left.rowCount, right.rowCount)
+
+	rindex := map[int][]int{}
+	for i, code := range rcodes {
+		if code >= 0 {
+			rindex[code] = append(rindex[code], i)
+		}
+	}
+
+	lrows, rrows := []int{}, []int{}
+
+	switch how {
+	case InnerJoin, LeftJoin, OuterJoin:
+		matched := make([]bool, right.rowCount)
+		for i, code := range lcodes {
+			matches := rindex[code]
+			if code < 0 || len(matches) == 0 {
+				if how != InnerJoin {
+					lrows = append(lrows, i)
+					rrows = append(rrows, -1)
+				}
+				continue
+			}
+
+			for _, j := range matches {
+				lrows = append(lrows, i)
+				rrows = append(rrows, j)
+				matched[j] = true
+			}
+		}
+
+		if how == OuterJoin {
+			for j, ok := range matched {
+				if !ok {
+					lrows = append(lrows, -1)
+					rrows = append(rrows, j)
+				}
+			}
+		}
+	case RightJoin:
+		lindex := map[int][]int{}
+		for i, code := range lcodes {
+			if code >= 0 {
+				lindex[code] = append(lindex[code], i)
+			}
+		}
+
+		for j, code := range rcodes {
+			matches := lindex[code]
+			if code < 0 || len(matches) == 0 {
+				lrows = append(lrows, -1)
+				rrows = append(rrows, j)
+				continue
+			}
+
+			for _, i := range matches {
+				lrows = append(lrows, i)
+				rrows = append(rrows, j)
+			}
+		}
+	case SemiJoin, AntiJoin:
+		rows := []int{}
+		for i, code := range lcodes {
+			found := code >= 0 && len(rindex[code]) > 0
+			if found == (how == SemiJoin) {
+				rows = append(rows, i)
+			}
+		}
+
+		return left.Take(rows)
+	default:
+		panic(fmt.Errorf("%w: unknown join type %d", ErrInvalidArgument, how))
+	}
+
+	isKey := map[string]bool{}
+	for _, name := range on {
+		isKey[name] = true
+	}
+
+	out := New()
+
+	for k, name := range on {
+		out.AddColumn(name, joinKeys(protos[k], lkeys[k], rkeys[k], lrows, rrows))
+	}
+
+	for i, name := range left.headers {
+		if isKey[name] {
+			continue
+		}
+		if _, clash := right.index[name]; clash {
+			name += leftSuffix
+		}
+		out.AddColumn(name, left.data[i].Take(lrows))
+	}
+
+	for i, name := range right.headers {
+		if isKey[name] {
+			continue
+		}
+		if _, clash := left.index[name]; clash {
+			name += rightSuffix
+		}
+		out.AddColumn(name, right.data[i].Take(rrows))
+	}
+
+	return out
+}
+
+// keyColumn returns an empty column that holds the keys of both l and r.
+// Keys of different numeric types are joined by value into a Float column,
+// or a Decimal of the larger scale when both are Decimal, and String keys
+// match Categorical ones as text.
+func keyColumn(l, r IColumn) IColumn {
+	if sameType(l, r) {
+		return l.New()
+	}
+
+	kind := func(col IColumn) string {
+		switch col.(type) {
+		case *Decimal:
+			return "decimal"
+		case *Int, *Float, *Int32, *Uint64, *Float32:
+			return "number"
+		case *String, *Categorical:
+			return "text"
+		default:
+			return fmt.Sprintf("%T", col)
+		}
+	}
+
+	switch a, b := kind(l), kind(r); {
+	case a == "decimal" && b == "decimal":
+		if r.(*Decimal).scale > l.(*Decimal).scale {
+			return r.New()
+		}
+		return l.New()
+	case (a == "number" || a == "decimal") && (b == "number" || b == "decimal"):
+		return NewFloat()
+	case a == "text" && b == "text":
+		return NewString()
+	default:
+		panic(fmt.Errorf("%w: cannot join %T keys with %T keys", ErrTypeMismatch, l, r))
+	}
+}
+
+// joinKeys builds the key column of the result, taking each row from the
+// left side and from the right side where the left one has no match.
+func joinKeys(proto, l, r IColumn, lrows, rrows []int) IColumn {
+	if sameType(proto, l) {
+		col := l.Take(lrows)
+		for i, j := range rrows {
+			if lrows[i] < 0 && j >= 0 && !r.IsNull(j) {
+				col.Set(i, coerce(col, r.Index(j)))
+			}
+		}
+		return col
+	}
+
+	col := proto
+	col.Extend(len(lrows))
+	for i := range lrows {
+		src, row := l, lrows[i]
+		if row < 0 {
+			src, row = r, rrows[i]
+		}
+		if row < 0 || src.IsNull(row) {
+			continue
+		}
+
+		v, err := castValue(col, src.Index(row), nil)
+		if err != nil {
+			panic(err)
+		}
+		col.Set(i, v)
+	}
+	return col
+}
+
+func joinCodes(lcols, rcols []IColumn, ln, rn int) ([]int, []int) {
+	lcodes, rcodes := make([]int, ln), make([]int, rn)
+
+	for k := range lcols {
+		lc, rc := keyCodes(lcols[k], rcols[k])
+		seen := map[[2]int]int{}
+
+		combine := func(codes, colCodes []int) {
+			for i := range codes {
+				if codes[i] < 0 || colCodes[i] < 0 {
+					codes[i] = -1
+					continue
+				}
+
+				key := [2]int{codes[i], colCodes[i]}
+				code, ok := seen[key]
+				if !ok {
+					code = len(seen)
+					seen[key] = code
+				}
+				codes[i] = code
+			}
+		}
+
+		combine(lcodes, lc)
+		combine(rcodes, rc)
+	}
+
+	return lcodes, rcodes
+}
+
+func keyCodes(l, r IColumn) ([]int, []int) {
+	lc, rc := make([]int, l.Len()), make([]int, r.Len())
+
+	switch a := l.(type) {
+	case *Int:
+		if b, ok := r.(*Int); ok {
+			sharedCodes(a.data, &a.nulls, b.data, &b.nulls, lc, rc)
+			return lc, rc
+		}
+	case *Float:
+		if b, ok := r.(*Float); ok {
+			sharedCodes(a.data, &a.nulls, b.data, &b.nulls, lc, rc)
+			return lc, rc
+		}
+	case *Bool:
+		if b, ok := r.(*Bool); ok {
+			sharedCodes(a.data, &a.nulls, b.data, &b.nulls, lc, rc)
+			return lc, rc
+		}
+	case *String:
+		if b, ok := r.(*String); ok {
+			sharedCodes(a.data, &a.nulls, b.data, &b.nulls, lc, rc)
+			return lc, rc
+		}
+	case *Int32:
+		if b, ok := r.(*Int32); ok {
+			sharedCodes(a.data, &a.nulls, b.data, &b.nulls, lc, rc)
+			return lc, rc
+		}
+	case *Uint64:
+		if b, ok := r.(*Uint64); ok {
+			sharedCodes(a.data, &a.nulls, b.data, &b.nulls, lc, rc)
+			return lc, rc
+		}
+	case *Float32:
+		if b, ok := r.(*Float32); ok {
+			sharedCodes(a.data, &a.nulls, b.data, &b.nulls, lc, rc)
+			return lc, rc
+		}
+	case *Decimal:
+		if b, ok := r.(*Decimal); ok && a.scale == b.scale {
+			sharedCodes(a.data, &a.nulls, b.data, &b.nulls, lc, rc)
+			return lc, rc
+		}
+	case *Categorical:
+		if b, ok := r.(*Categorical); ok {
+			sharedCodes(a.data, &a.nulls, a.recode(b), &b.nulls, lc, rc)
+			return lc, rc
+		}
+	}
+
+	seen := map[any]int{}
+	assign := func(col IColumn, codes []int) {
+		for i := range codes {
+			v := convert([]any{col.Index(i)})[0]
+			switch c := v.(type) {
+			case nil:
+				codes[i] = -1
+				continue
+			case int64:
+				v = float64(c)
+			case Fixed:
+				v = c.Float64()
+			case time.Time:
+				v = timeKey{c.Unix(), c.Nanosecond()}
+			}
+
+			code, ok := seen[v]
+			if !ok {
+				code = len(seen)
+				seen[v] = code
+			}
+			codes[i] = code
+		}
+	}
+
+	assign(l, lc)
+	assign(r, rc)
+	return lc, rc
+}
+
+func sharedCodes[T comparable](
+	l []T, lnulls *bitmap,
+	r []T, rnulls *bitmap,
+	lc, rc []int,
+) {
+	seen := map[T]int{}
+
+	assign := func(data []T, nulls *bitmap, codes []int) {
+		for i, v := range data {
+			if nulls.isNull(i) {
+				codes[i] = -1
+				continue
+			}
+
+			code, ok := seen[v]
+			if !ok {
+				code = len(seen)
+				seen[v] = code
+			}
+			codes[i] = code
+		}
+	}
+
+	assign(l, lnulls, lc)
+	assign(r, rnulls, rc)
+}
@@ -0,0 +1,113 @@
+package dataframe_test
+
+import (
+	"errors"
+	"fmt"
+	"go-numeric/dataframe"
+	"strings"
+	"testing"
+)
+
+func newJoinFrames() (*dataframe.DataFrame, *dataframe.DataFrame) {
+	left := dataframe.New()
+	left.AddColumn("region", dataframe.NewString("north", "south", "east", "north"))
+	left.AddColumn("year", dataframe.NewInt(2023, 2023, 2023, 2024))
+	left.AddColumn("sales", dataframe.NewFloat(1, 2, 3, 4))
+
+	right := dataframe.New()
+	right.AddColumn("region", dataframe.NewString("north", "south", "west"))
+	right.AddColumn("year", dataframe.NewInt(2023, 2023, 2023))
+	right.AddColumn("sales", dataframe.NewFloat(10, 20, 30))
+	right.AddColumn("target", dataframe.NewInt(5, 6, 7))
+
+	return left, right
+}
+
+func TestJoin(t *testing.T) {
+	left, right := newJoinFrames()
+	on := []string{"region", "year"}
+
+	tests := []struct {
+		how     dataframe.JoinHow
+		rows    int
+		regions string
+	}{
+		{dataframe.InnerJoin, 2, "north,south"},
+		{dataframe.LeftJoin, 4, "north,south,east,north"},
+		{dataframe.RightJoin, 3, "north,south,west"},
+		{dataframe.OuterJoin, 5, "north,south,east,north,west"},
+		{dataframe.SemiJoin, 2, "north,south"},
+		{dataframe.AntiJoin, 2, "east,north"},
+	}
+
+	for _, tt := range tests {
+		res := dataframe.Join(left, right, on, tt.how)
+		if res.Len() != tt.rows {
+			t.Errorf("join %d: expected %d rows, got %d", tt.how, tt.rows, res.Len())
+			continue
+		}
+
+		regions := []string{}
+		for i := 0; i < res.Len(); i++ {
+			regions = append(regions, res.Column("region").Index(i).(string))
+		}
+		if v := strings.Join(regions, ","); v != tt.regions {
+			t.Errorf("join %d: expected %s, got %s", tt.how, tt.regions, v)
+		}
+	}
+
+	res := dataframe.Join(left, right, on, dataframe.OuterJoin)
+	if h := strings.Join(res.Headers(), ","); h != "region,year,sales_left,sales_right,target" {
+		t.Fatalf("unexpected headers %s", h)
+	}
+	if !res.Column("target").IsNull(2) || !res.Column("sales_left").IsNull(4) {
+		t.Error("expected unmatched rows to be null")
+	}
+	if v := res.Column("year").Index(4); v != int64(2023) {
+		t.Errorf("expected key to be taken from the right frame, got %v", v)
+	}
+}
+
+func TestJoinDuplicatesAndNulls(t *testing.T) {
+	left := dataframe.New()
+	left.AddColumn("k", dataframe.NewInt(1, 2))
+	left.AppendRow(nil)
+
+	right := dataframe.New()
+	right.AddColumn("k", dataframe.NewInt(1, 1))
+	right.AppendRow(nil)
+	right.AddColumn("v", dataframe.NewString("a", "b", "c"))
+
+	res := dataframe.JoinSuffixes(left, right, []string{"k"}, dataframe.InnerJoin, "_l", "_r")
+	if res.Len() != 2 || res.Column("v").Index(1) != "b" {
+		t.Errorf("expected one row per match and no null matches, got %d rows", res.Len())
+	}
+}
+
+func TestJoinMixedKeys(t *testing.T) {
+	left := dataframe.New()
+	left.AddColumn("k", dataframe.NewInt(1, 2))
+	right := dataframe.New()
+	right.AddColumn("k", dataframe.NewFloat(2, 3.5))
+
+	res := dataframe.Join(left, right, []string{"k"}, dataframe.OuterJoin)
+	assertColumn(t, "outer", res.Column("k"), []any{1.0, 2.0, 3.5})
+
+	cents := dataframe.New()
+	cents.AddColumn("k", dataframe.NewDecimal(2, 200, 125))
+	whole := dataframe.New()
+	whole.AddColumn("k", dataframe.NewDecimal(0, 2))
+	res = dataframe.Join(whole, cents, []string{"k"}, dataframe.RightJoin)
+	if got := fmt.Sprint(res.Column("k").(*dataframe.Decimal).Data()); got != "[2.00 1.25]" {
+		t.Errorf("expected keys at the larger scale, got %s", got)
+	}
+
+	names := dataframe.New()
+	names.AddColumn("k", dataframe.NewString("1"))
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, dataframe.ErrTypeMismatch) {
+			t.Errorf("expected ErrTypeMismatch for String and Int keys, got %v", err)
+		}
+	}()
+	dataframe.Join(left, names, []string{"k"}, dataframe.InnerJoin)
+}
@@ -0,0 +1,569 @@
+package dataframe
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+type JSONFormat int
+
+const (
+	JSONRecords JSONFormat = iota
+	JSONColumns
+	JSONLines
+)
+
+type JSONOptions struct {
+	Lines     bool
+	ParseTime bool
+}
+
+func LoadJSON(rdr io.Reader) (*DataFrame, error) {
+	return LoadJSONOptions(rdr, JSONOptions{})
+}
+
+func LoadNDJSON(rdr io.Reader) (*DataFrame, error) {
+	return LoadJSONOptions(rdr, JSONOptions{Lines: true})
+}
+
+func LoadJSONOptions(rdr io.Reader, opts JSONOptions) (*DataFrame, error) {
+	dec := json.NewDecoder(rdr)
+	dec.UseNumber()
+
+	acc := &jsonColumns{values: map[string][]any{}}
+
+	if opts.Lines {
+		for {
+			tok, err := dec.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if tok != json.Delim('{') {
+				return nil, fmt.Errorf("expected object, got %v", tok)
+			}
+			if err := acc.readRecord(dec); err != nil {
+				return nil, err
+			}
+		}
+
+		return acc.frame(opts.ParseTime)
+	}
+
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return New(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('['):
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			if tok != json.Delim('{') {
+				return nil, fmt.Errorf("expected object, got %v", tok)
+			}
+			if err := acc.readRecord(dec); err != nil {
+				return nil, err
+			}
+		}
+	case json.Delim('{'):
+		for dec.More() {
+			name, err := readKey(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := readValue(dec)
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %w", name, err)
+			}
+			values, ok := v.([]any)
+			if !ok {
+				return nil, fmt.Errorf("column %q: expected array", name)
+			}
+			if err := acc.addColumn(name, values); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("expected array or object, got %v", tok)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	return acc.frame(opts.ParseTime)
+}
+
+func (df *DataFrame) ToJSON(wr io.Writer, format JSONFormat) error {
+	w := bufio.NewWriter(wr)
+
+	names := make([][]byte, len(df.headers))
+	for j, h := range df.headers {
+		b, err := json.Marshal(h)
+		if err != nil {
+			return err
+		}
+		names[j] = b
+	}
+
+	writeRecord := func(i int) error {
+		w.WriteByte('{')
+		for j, col := range df.data {
+			if j > 0 {
+				w.WriteByte(',')
+			}
+			w.Write(names[j])
+			w.WriteByte(':')
+
+			b, err := marshalCell(col, i)
+			if err != nil {
+				return fmt.Errorf("column %q: %w", df.headers[j], err)
+			}
+			w.Write(b)
+		}
+		w.WriteByte('}')
+		return nil
+	}
+
+	switch format {
+	case JSONRecords:
+		w.WriteByte('[')
+		for i := 0; i < df.rowCount; i++ {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			if err := writeRecord(i); err != nil {
+				return err
+			}
+		}
+		w.WriteString("]\n")
+	case JSONLines:
+		for i := 0; i < df.rowCount; i++ {
+			if err := writeRecord(i); err != nil {
+				return err
+			}
+			w.WriteByte('\n')
+		}
+	case JSONColumns:
+		w.WriteByte('{')
+		for j, col := range df.data {
+			if j > 0 {
+				w.WriteByte(',')
+			}
+			w.Write(names[j])
+			w.WriteString(":[")
+			for i := 0; i < df.rowCount; i++ {
+				if i > 0 {
+					w.WriteByte(',')
+				}
+
+				b, err := marshalCell(col, i)
+				if err != nil {
+					return fmt.Errorf("column %q: %w", df.headers[j], err)
+				}
+				w.Write(b)
+			}
+			w.WriteByte(']')
+		}
+		w.WriteString("}\n")
+	default:
+		return fmt.Errorf("unknown json format %d", format)
+	}
+
+	return w.Flush()
+}
+
+type jsonColumns struct {
+	names  []string
+	values map[string][]any
+	rows   int
+}
+
+func (acc *jsonColumns) readRecord(dec *json.Decoder) error {
+	for dec.More() {
+		name, err := readKey(dec)
+		if err != nil {
+			return err
+		}
+
+		v, err := readValue(dec)
+		if err != nil {
+			return fmt.Errorf("record %d: %w", acc.rows+1, err)
+		}
+
+		values, ok := acc.values[name]
+		if !ok {
+			acc.names = append(acc.names, name)
+			values = make([]any, acc.rows, acc.rows+1)
+		} else if len(values) > acc.rows {
+			return fmt.Errorf("record %d: duplicate key %q", acc.rows+1, name)
+		}
+		acc.values[name] = append(values, v)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	acc.rows++
+	for _, name := range acc.names {
+		if values := acc.values[name]; len(values) < acc.rows {
+			acc.values[name] = append(values, nil)
+		}
+	}
+
+	return nil
+}
+
+func (acc *jsonColumns) addColumn(name string, values []any) error {
+	if _, ok := acc.values[name]; ok {
+		return fmt.Errorf("duplicate column %q", name)
+	}
+	if len(acc.names) > 0 && len(values) != acc.rows {
+		return fmt.Errorf(
+			"column %q: expected %d values, got %d",
+			name, acc.rows, len(values),
+		)
+	}
+
+	acc.names = append(acc.names, name)
+	acc.values[name] = values
+	acc.rows = len(values)
+	return nil
+}
+
+func (acc *jsonColumns) frame(parseTime bool) (*DataFrame, error) {
+	df := New()
+
+	for _, name := range acc.names {
+		col, err := jsonColumn(acc.values[name], parseTime)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", name, err)
+		}
+		df.AddColumn(name, col)
+	}
+
+	return df, nil
+}
+
+func jsonColumn(values []any, parseTime bool) (IColumn, error) {
+	isInt, isFloat, isBool, isTime := true, true, true, parseTime
+	isList, isStruct := true, true
+	empty := true
+
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		empty = false
+
+		switch c := v.(type) {
+		case json.Number:
+			if _, err := c.Int64(); err != nil {
+				isInt = false
+			}
+			isBool, isTime = false, false
+		case bool:
+			isInt, isFloat, isTime = false, false, false
+		case string:
+			if isTime {
+				_, err := time.Parse(time.RFC3339Nano, c)
+				isTime = err == nil
+			}
+			isInt, isFloat, isBool = false, false, false
+		case []any:
+			isInt, isFloat, isBool, isTime, isStruct = false, false, false, false, false
+			continue
+		case *jsonObject:
+			isInt, isFloat, isBool, isTime, isList = false, false, false, false, false
+			continue
+		}
+		isList, isStruct = false, false
+	}
+
+	switch {
+	case empty:
+	case isList:
+		return jsonList(values, parseTime)
+	case isStruct:
+		return jsonStruct(values, parseTime)
+	}
+
+	var col IColumn
+	switch {
+	case empty:
+		col = NewString()
+	case isInt:
+		col = NewInt()
+	case isFloat:
+		col = NewFloat()
+	case isBool:
+		col = NewBool()
+	case isTime:
+		col = NewTime()
+	default:
+		col = NewString()
+	}
+
+	for _, v := range values {
+		if v == nil {
+			col.Extend(col.Len() + 1)
+			continue
+		}
+
+		switch c := col.(type) {
+		case *Int:
+			x, _ := v.(json.Number).Int64()
+			c.Append(x)
+		case *Float:
+			x, err := v.(json.Number).Float64()
+			if err != nil {
+				return nil, err
+			}
+			c.Append(x)
+		case *Bool:
+			c.Append(v.(bool))
+		case *Time:
+			x, _ := time.Parse(time.RFC3339Nano, v.(string))
+			c.Append(x)
+		case *String:
+			switch x := v.(type) {
+			case string:
+				c.Append(x)
+			case json.Number:
+				c.Append(x.String())
+			default:
+				b, err := json.Marshal(x)
+				if err != nil {
+					return nil, err
+				}
+				c.Append(string(b))
+			}
+		}
+	}
+
+	return col, nil
+}
+
+// jsonList flattens the elements of every array into one child column.
+func jsonList(values []any, parseTime bool) (IColumn, error) {
+	items := []any{}
+	lengths := make([]int, len(values))
+	for i, v := range values {
+		if v != nil {
+			lengths[i] = len(v.([]any))
+			items = append(items, v.([]any)...)
+		}
+	}
+
+	child, err := jsonColumn(items, parseTime)
+	if err != nil {
+		return nil, err
+	}
+
+	col := NewList(child, lengths...)
+	for i, v := range values {
+		if v == nil {
+			col.nulls.set(i, true)
+		}
+	}
+	return col, nil
+}
+
+// jsonStruct builds one field per key, in the order keys first appear.
+// Objects missing a key have a null in that field.
+func jsonStruct(values []any, parseTime bool) (IColumn, error) {
+	acc := &jsonColumns{values: map[string][]any{}}
+	for _, v := range values {
+		if obj, ok := v.(*jsonObject); ok {
+			for _, key := range obj.keys {
+				if _, seen := acc.values[key]; !seen {
+					acc.names = append(acc.names, key)
+					acc.values[key] = make([]any, len(values))
+				}
+			}
+		}
+	}
+
+	for i, v := range values {
+		if obj, ok := v.(*jsonObject); ok {
+			for key, x := range obj.values {
+				acc.values[key][i] = x
+			}
+		}
+	}
+
+	fields, err := acc.frame(parseTime)
+	if err != nil {
+		return nil, err
+	}
+	fields.rowCount = len(values)
+
+	col := NewStruct(fields)
+	for i, v := range values {
+		if v == nil {
+			col.nulls.set(i, true)
+		}
+	}
+	return col, nil
+}
+
+// jsonObject is a decoded JSON object that remembers the order of its keys.
+type jsonObject struct {
+	keys   []string
+	values map[string]any
+}
+
+func (obj *jsonObject) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, key := range obj.keys {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(obj.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf = append(append(append(buf, k...), ':'), v...)
+	}
+	return append(buf, '}'), nil
+}
+
+// readValue decodes the next value, keeping numbers as json.Number and
+// objects as *jsonObject.
+func readValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('['):
+		items := []any{}
+		for dec.More() {
+			v, err := readValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return items, nil
+	case json.Delim('{'):
+		obj := &jsonObject{values: map[string]any{}}
+		for dec.More() {
+			key, err := readKey(dec)
+			if err != nil {
+				return nil, err
+			}
+			v, err := readValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := obj.values[key]; !ok {
+				obj.keys = append(obj.keys, key)
+			}
+			obj.values[key] = v
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	default:
+		return tok, nil
+	}
+}
+
+func readKey(dec *json.Decoder) (string, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	key, ok := tok.(string)
+	if !ok {
+		return "", fmt.Errorf("expected key, got %v", tok)
+	}
+
+	return key, nil
+}
+
+func marshalValue(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return []byte("null"), nil
+		}
+		return []byte(formatText(v)), nil
+	case float32:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return []byte("null"), nil
+		}
+		return []byte(formatText(v)), nil
+	}
+
+	return json.Marshal(value)
+}
+
+// marshalCell encodes row i of col, writing List and Struct rows as JSON
+// arrays and objects with fields in column order.
+func marshalCell(col IColumn, i int) ([]byte, error) {
+	if col.IsNull(i) {
+		return []byte("null"), nil
+	}
+
+	switch c := col.(type) {
+	case *List:
+		buf := []byte{'['}
+		for j := c.offsets[i]; j < c.offsets[i+1]; j++ {
+			if j > c.offsets[i] {
+				buf = append(buf, ',')
+			}
+			b, err := marshalCell(c.values, j)
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, b...)
+		}
+		return append(buf, ']'), nil
+	case *Struct:
+		buf := []byte{'{'}
+		for k, name := range c.fields.headers {
+			if k > 0 {
+				buf = append(buf, ',')
+			}
+			key, err := json.Marshal(name)
+			if err != nil {
+				return nil, err
+			}
+			b, err := marshalCell(c.fields.data[k], i)
+			if err != nil {
+				return nil, err
+			}
+			buf = append(append(append(buf, key...), ':'), b...)
+		}
+		return append(buf, '}'), nil
+	default:
+		return marshalValue(col.Index(i))
+	}
+}
@@ -0,0 +1,123 @@
+package dataframe_test
+
+import (
+	"bytes"
+	"go-numeric/dataframe"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadJSON(t *testing.T) {
+	inputs := map[string]string{
+		"records": `[
+			{"id": 1, "score": 1.5, "ok": true, "at": "2024-01-02T03:04:05Z", "tag": "a"},
+			{"id": 2, "score": 2, "ok": false, "at": "2024-01-03T03:04:05Z", "tag": {"x": 1}}
+		]`,
+		"columns": `{
+			"id": [1, 2],
+			"score": [1.5, 2],
+			"ok": [true, false],
+			"at": ["2024-01-02T03:04:05Z", "2024-01-03T03:04:05Z"],
+			"tag": ["a", {"x": 1}]
+		}`,
+	}
+
+	for name, input := range inputs {
+		df, err := dataframe.LoadJSONOptions(strings.NewReader(input), dataframe.JSONOptions{
+			ParseTime: true,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if h := strings.Join(df.Headers(), ","); h != "id,score,ok,at,tag" {
+			t.Errorf("%s: unexpected headers %s", name, h)
+		}
+		if _, ok := df.Column("id").(*dataframe.Int); !ok {
+			t.Errorf("%s: id: expected Int, got %T", name, df.Column("id"))
+		}
+		if _, ok := df.Column("score").(*dataframe.Float); !ok {
+			t.Errorf("%s: score: expected Float, got %T", name, df.Column("score"))
+		}
+		if _, ok := df.Column("ok").(*dataframe.Bool); !ok {
+			t.Errorf("%s: ok: expected Bool, got %T", name, df.Column("ok"))
+		}
+		if _, ok := df.Column("at").(*dataframe.Time); !ok {
+			t.Errorf("%s: at: expected Time, got %T", name, df.Column("at"))
+		}
+		if v := df.Column("tag").Index(1); v != `{"x":1}` {
+			t.Errorf("%s: tag: unexpected value %v", name, v)
+		}
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("name", dataframe.NewString("a", `b "quoted"`))
+	df.AddColumn("value", dataframe.NewFloat(1, 2.5))
+	df.AddColumn("count", dataframe.NewInt(3, 4))
+	df.AddColumn("at", dataframe.NewTime(
+		time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		time.Date(2024, 1, 3, 3, 4, 5, 0, time.UTC),
+	))
+
+	formats := []dataframe.JSONFormat{
+		dataframe.JSONRecords,
+		dataframe.JSONColumns,
+		dataframe.JSONLines,
+	}
+
+	for _, format := range formats {
+		var buf bytes.Buffer
+		if err := df.ToJSON(&buf, format); err != nil {
+			t.Fatal(err)
+		}
+
+		df2, err := dataframe.LoadJSONOptions(&buf, dataframe.JSONOptions{
+			Lines:     format == dataframe.JSONLines,
+			ParseTime: true,
+		})
+		if err != nil {
+			t.Fatalf("format %d: %v", format, err)
+		}
+
+		for i := 0; i < df.Len(); i++ {
+			a, b := df.Row(i), df2.Row(i)
+			for j := range a {
+				if x, ok := a[j].(time.Time); ok {
+					if !x.Equal(b[j].(time.Time)) {
+						t.Errorf("format %d row %d: %v != %v", format, i, a[j], b[j])
+					}
+					continue
+				}
+				if a[j] != b[j] {
+					t.Errorf("format %d row %d: %v != %v", format, i, a[j], b[j])
+				}
+			}
+		}
+	}
+}
+
+func TestLoadNDJSON(t *testing.T) {
+	input := `{"a": 1}
+{"a": 2, "b": "x"}
+{"b": "y"}
+`
+
+	df, err := dataframe.LoadNDJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if df.Len() != 3 || df.NumColumns() != 2 {
+		t.Fatalf("unexpected shape %dx%d", df.NumColumns(), df.Len())
+	}
+	if v := df.Column("b").Index(2); v != "y" {
+		t.Errorf("unexpected value %v", v)
+	}
+
+	if _, err := dataframe.LoadJSON(strings.NewReader(`{"a": [1], "b": [1, 2]}`)); err == nil {
+		t.Error("expected error for uneven columns")
+	}
+}
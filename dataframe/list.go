@@ -0,0 +1,191 @@
+package dataframe
+
+import "fmt"
+
+// List stores a variable-length list per row. The elements of every row are
+// kept back to back in a single child column, with offsets marking where
+// each row starts. Null rows hold no elements.
+type List struct {
+	offsets []int
+	values  IColumn
+	nulls   bitmap
+}
+
+// NewList splits values into consecutive lists of the given lengths. With no
+// lengths it returns an empty column whose elements have the type of values.
+func NewList(values IColumn, lengths ...int) *List {
+	col := &List{offsets: []int{0}, values: values}
+	if len(lengths) == 0 {
+		col.values = values.New()
+	}
+
+	for _, n := range lengths {
+		col.offsets = append(col.offsets, col.offsets[len(col.offsets)-1]+n)
+	}
+	if end := col.offsets[len(col.offsets)-1]; end != col.values.Len() {
+		panic(fmt.Errorf("%w: list lengths add up to %d, got %d values", ErrLengthMismatch, end, col.values.Len()))
+	}
+
+	return col
+}
+
+func (col *List) New() IColumn {
+	return &List{offsets: []int{0}, values: col.values.New()}
+}
+
+func (col *List) Len() int {
+	return len(col.offsets) - 1
+}
+
+// Values returns the elements of every row as one column.
+func (col *List) Values() IColumn {
+	return col.values
+}
+
+// Lengths returns the number of elements in every row.
+func (col *List) Lengths() *Int {
+	out := &Int{Column[int64]{data: make([]int64, col.Len()), nulls: col.nulls.clone()}}
+	for i := range out.data {
+		out.data[i] = int64(col.offsets[i+1] - col.offsets[i])
+	}
+	return out
+}
+
+func (col *List) Extend(length int) {
+	n := col.Len()
+	if length > n {
+		col.nulls.setRange(n, length)
+		end := col.offsets[n]
+		for i := n; i < length; i++ {
+			col.offsets = append(col.offsets, end)
+		}
+	}
+}
+
+// Index returns the elements of a row as a []any.
+func (col *List) Index(idx int) any {
+	if col.nulls.isNull(idx) {
+		return nil
+	}
+
+	items := make([]any, 0, col.offsets[idx+1]-col.offsets[idx])
+	for j := col.offsets[idx]; j < col.offsets[idx+1]; j++ {
+		items = append(items, col.values.Index(j))
+	}
+	return items
+}
+
+func (col *List) IsNull(idx int) bool {
+	return col.nulls.isNull(idx)
+}
+
+func (col *List) NullCount() int {
+	return col.nulls.count
+}
+
+func (col *List) Clone() IColumn {
+	return &List{
+		offsets: append([]int{}, col.offsets...),
+		values:  col.values.Clone(),
+		nulls:   col.nulls.clone(),
+	}
+}
+
+func (col *List) DeleteRow(index int) {
+	rows := make([]int, 0, col.Len()-1)
+	for i := 0; i < col.Len(); i++ {
+		if i != index {
+			rows = append(rows, i)
+		}
+	}
+	*col = *col.Take(rows).(*List)
+}
+
+// Set replaces the elements of a row with a []any. Rows are stored back to
+// back, so setting any row but the last one rebuilds the child column. Fill
+// columns by extending them a row at a time, as AppendRow does.
+func (col *List) Set(index int, value any) {
+	var items []any
+	if value != nil {
+		var ok bool
+		if items, ok = value.([]any); !ok {
+			panic(fmt.Errorf("%w: cannot store %T in List", ErrTypeMismatch, value))
+		}
+	}
+
+	start := col.values.Len()
+	col.values.Extend(start + len(items))
+	for k, v := range items {
+		col.values.Set(start+k, coerce(col.values, v))
+	}
+
+	// an empty last row can take the new items where they were written
+	if index == col.Len()-1 && col.offsets[index] == start && col.offsets[index+1] == start {
+		col.offsets[index+1] = col.values.Len()
+		col.nulls.set(index, value == nil)
+		return
+	}
+
+	order := col.elements(rowRange(0, index))
+	for k := range items {
+		order = append(order, start+k)
+	}
+	order = append(order, col.elements(rowRange(index+1, col.Len()))...)
+	col.values = col.values.Take(order)
+
+	shift := len(items) - (col.offsets[index+1] - col.offsets[index])
+	for i := index + 1; i < len(col.offsets); i++ {
+		col.offsets[i] += shift
+	}
+	col.nulls.set(index, value == nil)
+}
+
+func (col *List) Take(indices []int) IColumn {
+	out := &List{offsets: make([]int, 1, len(indices)+1), nulls: takeNulls(&col.nulls, indices)}
+
+	elements := []int{}
+	for _, idx := range indices {
+		if idx >= 0 {
+			for j := col.offsets[idx]; j < col.offsets[idx+1]; j++ {
+				elements = append(elements, j)
+			}
+		}
+		out.offsets = append(out.offsets, len(elements))
+	}
+
+	out.values = col.values.Take(elements)
+	return out
+}
+
+func (col *List) Append(items ...any) {
+	start := col.values.Len()
+	col.values.Extend(start + len(items))
+	for k, v := range items {
+		col.values.Set(start+k, coerce(col.values, v))
+	}
+	col.offsets = append(col.offsets, col.values.Len())
+}
+
+func (col *List) AppendNull() {
+	col.nulls.set(col.Len(), true)
+	col.offsets = append(col.offsets, col.offsets[col.Len()])
+}
+
+// elements returns the positions in the child column of the given rows.
+func (col *List) elements(rows []int) []int {
+	out := []int{}
+	for _, i := range rows {
+		for j := col.offsets[i]; j < col.offsets[i+1]; j++ {
+			out = append(out, j)
+		}
+	}
+	return out
+}
+
+func rowRange(start, end int) []int {
+	rows := make([]int, 0, max(end-start, 0))
+	for i := start; i < end; i++ {
+		rows = append(rows, i)
+	}
+	return rows
+}
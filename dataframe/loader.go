@@ -1,25 +1,401 @@
 package dataframe
 
-import "io"
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
 
-func LoadStruct(data ...any) *DataFrame {
-	df := New()
-	return df
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	fixedType = reflect.TypeOf(Fixed{})
+)
+
+type structField struct {
+	name  string
+	index []int
+	typ   reflect.Type
 }
 
-func LoadCSV(rdr io.Reader) *DataFrame {
+func LoadStruct(data ...any) (*DataFrame, error) {
+	if len(data) == 1 {
+		v := reflect.ValueOf(data[0])
+		if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+			data = make([]any, v.Len())
+			for i := range data {
+				data[i] = v.Index(i).Interface()
+			}
+		}
+	}
+
 	df := New()
-	return df
+	if len(data) == 0 {
+		return df, nil
+	}
+
+	var typ reflect.Type
+	values := make([]reflect.Value, len(data))
+
+	for i, el := range data {
+		v := reflect.ValueOf(el)
+		for v.Kind() == reflect.Pointer && !v.IsNil() {
+			v = v.Elem()
+		}
+
+		if v.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("row %d: expected struct, got %T", i, el)
+		}
+		if typ == nil {
+			typ = v.Type()
+		} else if v.Type() != typ {
+			return nil, fmt.Errorf("row %d: expected %v, got %v", i, typ, v.Type())
+		}
+
+		values[i] = v
+	}
+
+	fields, err := structFields(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range fields {
+		col, err := columnFor(f.typ)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", f.name, err)
+		}
+		if d, ok := col.(*Decimal); ok {
+			// keep every digit by using the largest scale among the values
+			for _, v := range values {
+				if fv, err := v.FieldByIndexErr(f.index); err == nil {
+					for fv.Kind() == reflect.Pointer && !fv.IsNil() {
+						fv = fv.Elem()
+					}
+					if fv.Kind() == reflect.Struct {
+						d.scale = max(d.scale, min(fv.Interface().(Fixed).Scale, maxScale))
+					}
+				}
+			}
+		}
+
+		for i, v := range values {
+			fv, err := v.FieldByIndexErr(f.index)
+			if err != nil {
+				fv = reflect.Value{}
+			}
+
+			if err := appendValue(col, fv); err != nil {
+				return nil, fmt.Errorf("row %d field %q: %w", i, f.name, err)
+			}
+		}
+
+		df.AddColumn(f.name, col)
+	}
+
+	return df, nil
 }
 
-func LoadJSON(rdr io.Reader) *DataFrame {
-	df := New()
-	return df
+func structFields(typ reflect.Type) ([]structField, error) {
+	fields := []structField{}
+	seen := map[string]struct{}{}
+
+	for _, f := range reflect.VisibleFields(typ) {
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("df"), ",")
+		if name == "-" {
+			continue
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct && ft != timeType {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+		seen[name] = struct{}{}
+
+		fields = append(fields, structField{
+			name:  name,
+			index: f.Index,
+			typ:   ft,
+		})
+	}
+
+	return fields, nil
+}
+
+func columnFor(typ reflect.Type) (IColumn, error) {
+	if typ == timeType {
+		return NewTime(), nil
+	}
+
+	if typ == fixedType {
+		return NewDecimal(0), nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Int32:
+		return NewInt32(), nil
+	case reflect.Uint, reflect.Uint64:
+		return NewUint64(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return NewInt(), nil
+	case reflect.Float32:
+		return NewFloat32(), nil
+	case reflect.Float64:
+		return NewFloat(), nil
+	case reflect.Bool:
+		return NewBool(), nil
+	case reflect.String:
+		return NewString(), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %v", typ)
+	}
 }
 
-func (df *DataFrame) ToCSV() {
+func appendValue(col IColumn, v reflect.Value) error {
+	for v.IsValid() && v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v = reflect.Value{}
+			break
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		col.Extend(col.Len() + 1)
+		return nil
+	}
+
+	switch c := col.(type) {
+	case *Int:
+		var x int64
+		switch {
+		case v.CanInt():
+			x = v.Int()
+		case v.CanUint():
+			u := v.Uint()
+			if u > math.MaxInt64 {
+				return fmt.Errorf("value %d overflows int64", u)
+			}
+			x = int64(u)
+		}
+		c.Append(x)
+	case *Int32:
+		c.Append(int32(v.Int()))
+	case *Uint64:
+		c.Append(v.Uint())
+	case *Float32:
+		c.Append(float32(v.Float()))
+	case *Decimal:
+		f := v.Interface().(Fixed)
+		return catch(func() { c.Append(f) })
+	case *Float:
+		c.Append(v.Float())
+	case *Bool:
+		c.Append(v.Bool())
+	case *String:
+		c.Append(v.String())
+	case *Time:
+		c.Append(v.Interface().(time.Time))
+	default:
+		return fmt.Errorf("unsupported column type %T", col)
+	}
+
+	return nil
 }
 
-func (df *DataFrame) ToJSON() {
+func ToStructs[T any](df *DataFrame) ([]T, error) {
+	var out []T
+	if err := df.Unmarshal(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (df *DataFrame) Unmarshal(out any) error {
+	ptr := reflect.ValueOf(out)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("expected pointer to slice, got %T", out)
+	}
+
+	slice := ptr.Elem()
+	elemType := slice.Type().Elem()
+
+	structType := elemType
+	if structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("expected slice of structs, got %v", slice.Type())
+	}
+
+	fields, err := structFields(structType)
+	if err != nil {
+		return err
+	}
+
+	type binding struct {
+		field structField
+		col   IColumn
+	}
+
+	bindings := []binding{}
+	for _, f := range fields {
+		idx, ok := df.index[f.name]
+		if !ok {
+			continue
+		}
+		bindings = append(bindings, binding{f, df.data[idx]})
+	}
+
+	result := reflect.MakeSlice(slice.Type(), df.rowCount, df.rowCount)
+
+	for i := 0; i < df.rowCount; i++ {
+		elem := result.Index(i)
+		if elemType.Kind() == reflect.Pointer {
+			elem.Set(reflect.New(structType))
+			elem = elem.Elem()
+		}
+
+		for _, b := range bindings {
+			fv := fieldByIndexAlloc(elem, b.field.index)
+			if err := setValue(fv, b.col.Index(i)); err != nil {
+				return fmt.Errorf("row %d column %q: %w", i, b.field.name, err)
+			}
+		}
+	}
+
+	slice.Set(result)
+	return nil
+}
+
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+
+	return v
+}
+
+func setValue(fv reflect.Value, value any) error {
+	if fv.Kind() == reflect.Pointer {
+		if value == nil {
+			fv.Set(reflect.Zero(fv.Type()))
+			return nil
+		}
+
+		elem := reflect.New(fv.Type().Elem())
+		if err := setValue(elem.Elem(), value); err != nil {
+			return err
+		}
+		fv.Set(elem)
+		return nil
+	}
+
+	if value == nil {
+		fv.Set(reflect.Zero(fv.Type()))
+		return nil
+	}
+
+	mismatch := func() error {
+		return fmt.Errorf("cannot assign %T to %v", value, fv.Type())
+	}
+
+	if fv.Type() == fixedType {
+		if v, ok := value.(Fixed); ok {
+			fv.Set(reflect.ValueOf(v))
+			return nil
+		}
+		return mismatch()
+	}
+
+	switch v := convert([]any{value})[0].(type) {
+	case int64:
+		switch {
+		case fv.CanInt():
+			if fv.OverflowInt(v) {
+				return fmt.Errorf("value %d overflows %v", v, fv.Type())
+			}
+			fv.SetInt(v)
+		case fv.CanUint():
+			if v < 0 || fv.OverflowUint(uint64(v)) {
+				return fmt.Errorf("value %d overflows %v", v, fv.Type())
+			}
+			fv.SetUint(uint64(v))
+		case fv.CanFloat():
+			fv.SetFloat(float64(v))
+		default:
+			return mismatch()
+		}
+	case float64:
+		if !fv.CanFloat() {
+			return mismatch()
+		}
+		if fv.OverflowFloat(v) {
+			return fmt.Errorf("value %v overflows %v", v, fv.Type())
+		}
+		fv.SetFloat(v)
+	case uint64:
+		switch {
+		case fv.CanUint() && !fv.OverflowUint(v):
+			fv.SetUint(v)
+		case fv.CanFloat():
+			fv.SetFloat(float64(v))
+		case fv.CanInt() || fv.CanUint():
+			return fmt.Errorf("value %d overflows %v", v, fv.Type())
+		default:
+			return mismatch()
+		}
+	case Fixed:
+		if !fv.CanFloat() {
+			return mismatch()
+		}
+		fv.SetFloat(v.Float64())
+	case bool:
+		if fv.Kind() != reflect.Bool {
+			return mismatch()
+		}
+		fv.SetBool(v)
+	case string:
+		if fv.Kind() != reflect.String {
+			return mismatch()
+		}
+		fv.SetString(v)
+	case time.Time:
+		if fv.Type() != timeType {
+			return mismatch()
+		}
+		fv.Set(reflect.ValueOf(v))
+	default:
+		rv := reflect.ValueOf(v)
+		if !rv.Type().AssignableTo(fv.Type()) {
+			return mismatch()
+		}
+		fv.Set(rv)
+	}
 
+	return nil
 }
@@ -0,0 +1,180 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"strings"
+	"testing"
+	"time"
+)
+
+type Base struct {
+	ID uint32 `df:"id"`
+}
+
+type Reading struct {
+	Base
+	Sensor   string    `df:"sensor"`
+	Value    float32   `df:"value"`
+	Ok       bool      `df:"ok"`
+	TakenAt  time.Time `df:"taken_at"`
+	Comment  *string
+	Internal string `df:"-"`
+	private  int
+}
+
+func TestLoadStruct(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	comment := "recalibrated"
+
+	readings := []Reading{
+		{Base: Base{ID: 1}, Sensor: "a", Value: 1.5, Ok: true, TakenAt: at},
+		{Base: Base{ID: 2}, Sensor: "b", Value: 2.5, TakenAt: at.Add(time.Hour), Comment: &comment},
+	}
+
+	for _, input := range [][]any{{readings}, {&readings[0], &readings[1]}} {
+		df, err := dataframe.LoadStruct(input...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if h := strings.Join(df.Headers(), ","); h != "id,sensor,value,ok,taken_at,Comment" {
+			t.Fatalf("unexpected headers %s", h)
+		}
+		if df.Len() != 2 {
+			t.Fatalf("expected 2 rows, got %d", df.Len())
+		}
+
+		if v := df.Column("id").(*dataframe.Int).Sum(); v != 3 {
+			t.Errorf("id: unexpected sum %d", v)
+		}
+		if v := df.Column("value").(*dataframe.Float32).Sum(); v != 4 {
+			t.Errorf("value: unexpected sum %v", v)
+		}
+		if v := df.Column("taken_at").Index(1).(time.Time); !v.Equal(at.Add(time.Hour)) {
+			t.Errorf("taken_at: unexpected value %v", v)
+		}
+		if v := df.Column("Comment").Index(1); v != comment {
+			t.Errorf("Comment: unexpected value %v", v)
+		}
+	}
+}
+
+func TestLoadStructErrors(t *testing.T) {
+	type nested struct {
+		Values []int
+	}
+	type mixed struct {
+		A int
+	}
+
+	inputs := [][]any{
+		{[]int{1, 2}},
+		{nested{Values: []int{1}}},
+		{Reading{}, mixed{}},
+	}
+
+	for _, input := range inputs {
+		if _, err := dataframe.LoadStruct(input...); err == nil {
+			t.Errorf("expected error for %v", input)
+		}
+	}
+}
+
+func TestLoadStructNumericTypes(t *testing.T) {
+	type account struct {
+		Small   int32            `df:"small"`
+		ID      uint64           `df:"id"`
+		Ratio   float32          `df:"ratio"`
+		Balance dataframe.Fixed  `df:"balance"`
+		Limit   *dataframe.Fixed `df:"limit"`
+	}
+
+	limit := dataframe.Fixed{Units: 5, Scale: 0}
+	rows := []account{
+		{Small: -7, ID: 1 << 63, Ratio: 0.5, Balance: dataframe.Fixed{Units: 1050, Scale: 2}, Limit: &limit},
+		{Small: 8, ID: 42, Ratio: 1.25, Balance: dataframe.Fixed{Units: 3, Scale: 0}},
+	}
+
+	df, err := dataframe.LoadStruct(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := df.Column("small").(*dataframe.Int32); !ok {
+		t.Errorf("small: expected Int32, got %T", df.Column("small"))
+	}
+	if _, ok := df.Column("ratio").(*dataframe.Float32); !ok {
+		t.Errorf("ratio: expected Float32, got %T", df.Column("ratio"))
+	}
+	assertColumn(t, "id", df.Column("id"), []any{uint64(1 << 63), uint64(42)})
+
+	balance, ok := df.Column("balance").(*dataframe.Decimal)
+	if !ok || balance.Scale() != 2 {
+		t.Fatalf("balance: expected a Decimal of scale 2, got %T", df.Column("balance"))
+	}
+	assertColumn(t, "balance", balance, []any{
+		dataframe.Fixed{Units: 1050, Scale: 2}, dataframe.Fixed{Units: 300, Scale: 2},
+	})
+	assertColumn(t, "limit", df.Column("limit"), []any{dataframe.Fixed{Units: 5}, nil})
+
+	back, err := dataframe.ToStructs[account](df)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back[0].ID != 1<<63 || back[1].Small != 8 || back[0].Balance.Units != 1050 {
+		t.Errorf("unexpected round trip %+v", back)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewInt(1, 2))
+	df.AddColumn("sensor", dataframe.NewString("a", "b"))
+	df.AddColumn("value", dataframe.NewFloat(1.5, 2.5))
+	df.AddColumn("taken_at", dataframe.NewTime(at, at.Add(time.Hour)))
+	df.AddColumn("Comment", dataframe.NewString("", "recalibrated"))
+	df.AddColumn("unused", dataframe.NewBool(true, false))
+
+	readings, err := dataframe.ToStructs[Reading](df)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(readings) != 2 {
+		t.Fatalf("expected 2 readings, got %d", len(readings))
+	}
+
+	r := readings[1]
+	if r.ID != 2 || r.Sensor != "b" || r.Value != 2.5 || !r.TakenAt.Equal(at.Add(time.Hour)) {
+		t.Errorf("unexpected reading %+v", r)
+	}
+	if r.Comment == nil || *r.Comment != "recalibrated" {
+		t.Errorf("unexpected comment %v", r.Comment)
+	}
+
+	var ptrs []*Reading
+	if err := df.Unmarshal(&ptrs); err != nil {
+		t.Fatal(err)
+	}
+	if ptrs[0].Sensor != "a" {
+		t.Errorf("unexpected reading %+v", ptrs[0])
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("A", dataframe.NewInt(1, 300))
+	df.AddColumn("B", dataframe.NewString("x", "y"))
+
+	if _, err := dataframe.ToStructs[struct{ A int8 }](df); err == nil {
+		t.Error("expected overflow error")
+	}
+	if _, err := dataframe.ToStructs[struct{ B int }](df); err == nil {
+		t.Error("expected type mismatch error")
+	}
+	if err := df.Unmarshal([]struct{ A int }{}); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+}
@@ -0,0 +1,146 @@
+package dataframe
+
+import "fmt"
+
+type DropHow int
+
+const (
+	DropAny DropHow = iota
+	DropAll
+)
+
+func (df *DataFrame) DropNA(how DropHow, subset ...string) *DataFrame {
+	cols := df.columns(subset)
+	rows := []int{}
+
+	for i := 0; i < df.rowCount; i++ {
+		nulls := 0
+		for _, col := range cols {
+			if col.IsNull(i) {
+				nulls++
+			}
+		}
+
+		switch {
+		case how == DropAny && nulls > 0:
+		case how == DropAll && nulls == len(cols) && len(cols) > 0:
+		default:
+			rows = append(rows, i)
+		}
+	}
+
+	return df.Take(rows)
+}
+
+func (df *DataFrame) FillNA(values map[string]any) {
+	for name, value := range values {
+		col := df.Column(name)
+		value = coerce(col, value)
+
+		for i := 0; i < df.rowCount; i++ {
+			if col.IsNull(i) {
+				col.Set(i, value)
+			}
+		}
+	}
+}
+
+func (df *DataFrame) FillForward(columns ...string) {
+	for _, col := range df.columns(columns) {
+		var last any
+		for i := 0; i < df.rowCount; i++ {
+			if !col.IsNull(i) {
+				last = col.Index(i)
+			} else if last != nil {
+				col.Set(i, last)
+			}
+		}
+	}
+}
+
+func (df *DataFrame) FillBackward(columns ...string) {
+	for _, col := range df.columns(columns) {
+		var next any
+		for i := df.rowCount - 1; i >= 0; i-- {
+			if !col.IsNull(i) {
+				next = col.Index(i)
+			} else if next != nil {
+				col.Set(i, next)
+			}
+		}
+	}
+}
+
+// Interpolate fills gaps between two values of a Float column linearly by
+// row position. Leading and trailing nulls are left as they are.
+func (df *DataFrame) Interpolate(column string) {
+	col, ok := df.Column(column).(*Float)
+	if !ok {
+		panic(fmt.Errorf("%w: interpolation requires a Float column", ErrTypeMismatch))
+	}
+
+	interpolate(col, nil, func(i int) float64 {
+		return float64(i)
+	})
+}
+
+// InterpolateTime fills gaps between two values of a Float column weighted
+// by the distance between rows of the Time column.
+func (df *DataFrame) InterpolateTime(column, timeColumn string) {
+	col, ok := df.Column(column).(*Float)
+	if !ok {
+		panic(fmt.Errorf("%w: interpolation requires a Float column", ErrTypeMismatch))
+	}
+
+	index, ok := df.Column(timeColumn).(*Time)
+	if !ok {
+		panic(fmt.Errorf("%w: interpolation index must be a Time column", ErrTypeMismatch))
+	}
+
+	interpolate(col, &index.nulls, func(i int) float64 {
+		t := index.data[i]
+		return float64(t.Unix()) + float64(t.Nanosecond())/1e9
+	})
+}
+
+func interpolate(col *Float, skip *bitmap, pos func(i int) float64) {
+	prev := -1
+
+	for i := range col.data {
+		if col.nulls.isNull(i) || (skip != nil && skip.isNull(i)) {
+			continue
+		}
+
+		if prev >= 0 && i-prev > 1 {
+			x0, x1 := pos(prev), pos(i)
+			y0, y1 := col.data[prev], col.data[i]
+
+			for j := prev + 1; j < i; j++ {
+				if !col.nulls.isNull(j) || (skip != nil && skip.isNull(j)) {
+					continue
+				}
+
+				if x1 == x0 {
+					col.Set(j, y0)
+					continue
+				}
+				col.Set(j, y0+(y1-y0)*(pos(j)-x0)/(x1-x0))
+			}
+		}
+
+		prev = i
+	}
+}
+
+func (df *DataFrame) columns(names []string) []IColumn {
+	if len(names) == 0 {
+		return df.data
+	}
+
+	cols := make([]IColumn, len(names))
+	for i, name := range names {
+		cols[i] = df.Column(name)
+	}
+
+	return cols
+}
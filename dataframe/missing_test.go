@@ -0,0 +1,83 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"testing"
+	"time"
+)
+
+func newGappy() *dataframe.DataFrame {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	df := dataframe.New()
+	df.AddColumn("at", dataframe.NewTime(
+		start,
+		start.Add(2*time.Hour),
+		start.Add(3*time.Hour),
+		start.Add(6*time.Hour),
+	))
+	df.AddColumn("plant", dataframe.NewString("a", "b", "c", "d"))
+	df.AddColumn("reading", dataframe.NewFloat(1))
+	df.Column("reading").Set(3, 7.0)
+	df.AppendRow(start.Add(7 * time.Hour))
+
+	return df
+}
+
+func TestDropNA(t *testing.T) {
+	df := newGappy()
+
+	if v := df.DropNA(dataframe.DropAny).Len(); v != 2 {
+		t.Errorf("any: expected 2 rows, got %d", v)
+	}
+	if v := df.DropNA(dataframe.DropAll, "plant", "reading").Len(); v != 4 {
+		t.Errorf("all: expected 4 rows, got %d", v)
+	}
+	if v := df.DropNA(dataframe.DropAny, "plant").Len(); v != 4 {
+		t.Errorf("subset: expected 4 rows, got %d", v)
+	}
+}
+
+func TestFillNA(t *testing.T) {
+	df := newGappy()
+	df.FillNA(map[string]any{"reading": 0, "plant": "unknown"})
+
+	if v := df.Column("reading").(*dataframe.Float).Sum(); v != 8 {
+		t.Errorf("expected sum 8, got %v", v)
+	}
+	if v := df.Column("plant").Index(4); v != "unknown" {
+		t.Errorf("expected unknown, got %v", v)
+	}
+
+	df = newGappy()
+	df.FillForward("reading")
+	if v := df.Column("reading").(*dataframe.Float).Data(); v[1] != 1 || v[2] != 1 || v[4] != 7 {
+		t.Errorf("unexpected forward fill %v", v)
+	}
+
+	df = newGappy()
+	df.FillBackward()
+	if v := df.Column("reading").(*dataframe.Float).Data(); v[1] != 7 || !df.Column("reading").IsNull(4) {
+		t.Errorf("unexpected backward fill %v", v)
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	df := newGappy()
+	df.Interpolate("reading")
+
+	reading := df.Column("reading").(*dataframe.Float)
+	if v := reading.Data(); v[1] != 3 || v[2] != 5 {
+		t.Errorf("unexpected linear interpolation %v", v)
+	}
+	if !reading.IsNull(4) {
+		t.Error("expected trailing null to remain")
+	}
+
+	df = newGappy()
+	df.InterpolateTime("reading", "at")
+
+	if v := df.Column("reading").(*dataframe.Float).Data(); v[1] != 3 || v[2] != 4 {
+		t.Errorf("unexpected time interpolation %v", v)
+	}
+}
@@ -0,0 +1,129 @@
+package dataframe_test
+
+import (
+	"bytes"
+	"errors"
+	"go-numeric/dataframe"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	col := dataframe.NewList(dataframe.NewInt(1, 2, 3), 2, 0, 1)
+	col.AppendNull()
+	col.Append(4, 5)
+
+	assertColumn(t, "lengths", col.Lengths(), []any{int64(2), int64(0), int64(1), nil, int64(2)})
+
+	col.Set(1, []any{6, 7, 8})
+	col.Set(0, nil)
+	col.DeleteRow(2)
+
+	want := [][]any{nil, {int64(6), int64(7), int64(8)}, nil, {int64(4), int64(5)}}
+	if col.Len() != len(want) {
+		t.Fatalf("expected %d rows, got %d", len(want), col.Len())
+	}
+	for i, w := range want {
+		got, _ := col.Index(i).([]any)
+		if (w == nil) != col.IsNull(i) || !slices.Equal(got, w) {
+			t.Errorf("row %d: expected %v, got %v", i, w, col.Index(i))
+		}
+	}
+
+	last := dataframe.NewList(dataframe.NewInt(1, 2), 2)
+	last.Set(0, []any{int64(3)})
+	if got, _ := last.Index(0).([]any); !slices.Equal(got, []any{int64(3)}) {
+		t.Errorf("expected the last row to be replaced, got %v", last.Index(0))
+	}
+
+	taken := col.Take([]int{3, -1, 1}).(*dataframe.List)
+	assertColumn(t, "taken", taken.Values(), []any{int64(4), int64(5), int64(6), int64(7), int64(8)})
+	if !taken.IsNull(1) {
+		t.Error("expected taken row 1 to be null")
+	}
+}
+
+func TestExplodeUnnest(t *testing.T) {
+	payload := `[
+		{"id": 1, "tags": ["a", "b"], "user": {"name": "ann", "age": 31}},
+		{"id": 2, "tags": [], "user": {"name": "bob"}},
+		{"id": 3, "tags": null, "user": null}
+	]`
+
+	df, err := dataframe.LoadJSON(strings.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := df.Column("tags").(*dataframe.List); !ok {
+		t.Fatalf("expected List column, got %T", df.Column("tags"))
+	}
+	user, ok := df.Column("user").(*dataframe.Struct)
+	if !ok {
+		t.Fatalf("expected Struct column, got %T", df.Column("user"))
+	}
+	if f := strings.Join(user.Fields(), ","); f != "name,age" {
+		t.Errorf("unexpected fields %s", f)
+	}
+
+	exploded := df.Explode("tags")
+	assertColumn(t, "id", exploded.Column("id"), []any{int64(1), int64(1), int64(2), int64(3)})
+	assertColumn(t, "tags", exploded.Column("tags"), []any{"a", "b", nil, nil})
+
+	flat := df.Unnest("user")
+	if h := strings.Join(flat.Headers(), ","); h != "id,tags,user.name,user.age" {
+		t.Fatalf("unexpected headers %s", h)
+	}
+	assertColumn(t, "name", flat.Column("user.name"), []any{"ann", "bob", nil})
+	assertColumn(t, "age", flat.Column("user.age"), []any{int64(31), nil, nil})
+
+	var buf bytes.Buffer
+	if err := df.ToJSON(&buf, dataframe.JSONLines); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":1,"tags":["a","b"],"user":{"name":"ann","age":31}}
+{"id":2,"tags":[],"user":{"name":"bob","age":null}}
+{"id":3,"tags":null,"user":null}
+`
+	if buf.String() != want {
+		t.Errorf("unexpected JSON:\n%s", buf.String())
+	}
+}
+
+func TestNestedRows(t *testing.T) {
+	expectPanic := func(name string, target error, f func()) {
+		t.Helper()
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, target) {
+				t.Errorf("%s: expected %v, got %v", name, target, err)
+			}
+		}()
+		f()
+	}
+
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewInt(1, 2))
+	df.AddColumn("tags", dataframe.NewList(dataframe.NewString("a", "b", "c"), 2, 1))
+	df.AppendRow(3, []any{"d", "e"})
+	df.AppendRow(4, nil)
+
+	both, err := dataframe.Concat(df, df)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := both.Column("tags").(*dataframe.List)
+	assertColumn(t, "lengths", tags.Lengths(), []any{int64(2), int64(1), int64(2), nil, int64(2), int64(1), int64(2), nil})
+	assertColumn(t, "values", tags.Values(), []any{"a", "b", "c", "d", "e", "a", "b", "c", "d", "e"})
+
+	expectPanic("GroupBy", dataframe.ErrTypeMismatch, func() { df.GroupBy("tags") })
+
+	report := df.Validate(dataframe.Schema{{Name: "tags", Unique: true, Nullable: true}})
+	if len(report.Violations) != 1 || report.Violations[0].Rule != dataframe.RuleType {
+		t.Errorf("expected a type violation for a unique List, got %v", report.Violations)
+	}
+
+	user := dataframe.NewStruct(dataframe.New())
+	user.Extend(1)
+	expectPanic("Struct.Set", dataframe.ErrTypeMismatch, func() { user.Set(0, "ann") })
+}
@@ -0,0 +1,124 @@
+package dataframe_test
+
+import (
+	"bytes"
+	"go-numeric/dataframe"
+	"strings"
+	"testing"
+)
+
+func TestNulls(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("sensor", dataframe.NewString("a", "b", "c"))
+	df.AddColumn("reading", dataframe.NewFloat(1, 3))
+	df.AppendRow("d", nil)
+	df.AppendRow("e", 5.0)
+
+	reading := df.Column("reading").(*dataframe.Float)
+	if reading.NullCount() != 2 || !reading.IsNull(2) || !reading.IsNull(3) {
+		t.Fatalf("expected rows 2 and 3 to be null, got %d nulls", reading.NullCount())
+	}
+	if reading.Index(2) != nil {
+		t.Errorf("expected nil for null row, got %v", reading.Index(2))
+	}
+
+	if v := reading.Sum(); v != 9 {
+		t.Errorf("sum: expected 9, got %v", v)
+	}
+	if v := reading.Mean(); v != 3 {
+		t.Errorf("mean: expected 3, got %v", v)
+	}
+	if v := reading.Min(); v != 1 {
+		t.Errorf("min: expected 1, got %v", v)
+	}
+	if v := reading.Median(); v != 3 {
+		t.Errorf("median: expected 3, got %v", v)
+	}
+
+	nulls := df.Filtered(&dataframe.IsNull{Column: "reading"})
+	if nulls.Len() != 2 || nulls.Column("sensor").Index(1) != "d" {
+		t.Errorf("unexpected IsNull result %v", nulls.Row(0))
+	}
+
+	present := df.Filtered(&dataframe.NotNull{Column: "reading"})
+	if present.Len() != 3 || present.Column("reading").NullCount() != 0 {
+		t.Errorf("unexpected NotNull result with %d rows", present.Len())
+	}
+
+	if v := df.Filtered(&dataframe.LT{"reading", 0, 2.0}).Len(); v != 1 {
+		t.Errorf("expected nulls to never match comparisons, got %d rows", v)
+	}
+
+	df.SortBy("reading", false)
+	if v := df.Column("sensor").Index(0); v != "e" {
+		t.Errorf("expected e first after sort, got %v", v)
+	}
+	if !df.Column("reading").IsNull(4) {
+		t.Error("expected nulls to sort last")
+	}
+
+	var buf bytes.Buffer
+	df.Format(&buf)
+	if !strings.Contains(buf.String(), "null") {
+		t.Errorf("expected null in output:\n%s", buf.String())
+	}
+}
+
+func TestNullColumnOps(t *testing.T) {
+	a := dataframe.NewInt(1, 2, 3)
+	b := dataframe.NewInt(1, 1)
+	b.AppendNull()
+
+	a.Add(b)
+	if !a.IsNull(2) || a.Index(0) != int64(2) {
+		t.Errorf("expected null to propagate, got %v", a.Data())
+	}
+
+	a.DeleteRow(0)
+	if a.Len() != 2 || !a.IsNull(1) || a.NullCount() != 1 {
+		t.Errorf("expected null to shift with deleted row, got %v", a.Data())
+	}
+
+	c := a.Clone()
+	c.Set(1, int64(7))
+	if !a.IsNull(1) || c.IsNull(1) {
+		t.Error("expected clone to have independent nulls")
+	}
+
+	s := dataframe.NewString("b", "a")
+	s.AppendNull()
+	s.Set(0, nil)
+	s.SortBy(true)
+	if s.Index(0) != "a" || !s.IsNull(1) || !s.IsNull(2) {
+		t.Errorf("unexpected sort result %v", s.Data())
+	}
+}
+
+func TestNullsRoundTrip(t *testing.T) {
+	input := "a,b\n1,\n,x\n"
+
+	df, err := dataframe.LoadCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !df.Column("a").IsNull(1) || !df.Column("b").IsNull(0) {
+		t.Fatal("expected empty cells to load as nulls")
+	}
+
+	var buf bytes.Buffer
+	if err := df.ToCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != input {
+		t.Errorf("expected %q, got %q", input, buf.String())
+	}
+
+	buf.Reset()
+	if err := df.ToJSON(&buf, dataframe.JSONRecords); err != nil {
+		t.Fatal(err)
+	}
+	if v := strings.TrimSpace(buf.String()); v != `[{"a":1,"b":null},{"a":null,"b":"x"}]` {
+		t.Errorf("unexpected json %s", v)
+	}
+}
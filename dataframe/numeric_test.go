@@ -0,0 +1,76 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"testing"
+)
+
+func assertColumn(t *testing.T, name string, col dataframe.IColumn, want []any) {
+	t.Helper()
+
+	if col.Len() != len(want) {
+		t.Fatalf("%s: expected %d values, got %d", name, len(want), col.Len())
+	}
+	for i, v := range want {
+		if got := col.Index(i); got != v {
+			t.Errorf("%s[%d]: expected %v, got %v", name, i, v, got)
+		}
+	}
+}
+
+func TestShiftDiff(t *testing.T) {
+	col := dataframe.NewInt(1, 3)
+	col.AppendNull()
+	col.Append(10)
+
+	assertColumn(t, "shift", col.Shift(1), []any{nil, int64(1), int64(3), nil})
+	assertColumn(t, "lead", col.Shift(-1), []any{int64(3), nil, int64(10), nil})
+	assertColumn(t, "diff", col.Diff(1), []any{nil, int64(2), nil, nil})
+	assertColumn(t, "pct", dataframe.NewFloat(2, 3, 0, 1).PctChange(1), []any{nil, 0.5, -1.0, nil})
+}
+
+func TestCumulative(t *testing.T) {
+	col := dataframe.NewFloat(2, 1)
+	col.AppendNull()
+	col.Append(3)
+
+	assertColumn(t, "cumsum", col.CumSum(), []any{2.0, 3.0, nil, 6.0})
+	assertColumn(t, "cumprod", col.CumProd(), []any{2.0, 2.0, nil, 6.0})
+	assertColumn(t, "cummax", col.CumMax(), []any{2.0, 2.0, nil, 3.0})
+	assertColumn(t, "cummin", col.CumMin(), []any{2.0, 1.0, nil, 1.0})
+}
+
+func TestRank(t *testing.T) {
+	col := dataframe.NewInt(30, 10, 20, 10)
+	col.AppendNull()
+
+	tests := map[dataframe.RankMethod][]any{
+		dataframe.RankAverage: {4.0, 1.5, 3.0, 1.5, nil},
+		dataframe.RankMin:     {4.0, 1.0, 3.0, 1.0, nil},
+		dataframe.RankMax:     {4.0, 2.0, 3.0, 2.0, nil},
+		dataframe.RankDense:   {3.0, 1.0, 2.0, 1.0, nil},
+		dataframe.RankOrdinal: {4.0, 1.0, 3.0, 2.0, nil},
+	}
+
+	for method, want := range tests {
+		assertColumn(t, "rank", col.Rank(method), want)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("g", dataframe.NewString("a", "b", "a", "b", "a"))
+	df.AddColumn("x", dataframe.NewInt(1, 10, 2, 20, 3))
+
+	g := df.GroupBy("g")
+
+	cumsum := g.Transform("x", func(col dataframe.IColumn) dataframe.IColumn {
+		return col.(*dataframe.Int).CumSum()
+	})
+	assertColumn(t, "cumsum", cumsum, []any{int64(1), int64(10), int64(3), int64(30), int64(6)})
+
+	lag := g.Transform("x", func(col dataframe.IColumn) dataframe.IColumn {
+		return col.(*dataframe.Int).Shift(1)
+	})
+	assertColumn(t, "lag", lag, []any{nil, nil, int64(1), int64(10), int64(2)})
+}
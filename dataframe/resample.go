@@ -0,0 +1,124 @@
+package dataframe
+
+import (
+	"fmt"
+	"time"
+)
+
+type Calendar int
+
+const (
+	Daily Calendar = iota
+	Weekly
+	Monthly
+	Quarterly
+)
+
+// Resampler buckets the rows of a frame by a Time column into contiguous
+// intervals between the earliest and latest time. Rows with a null time are
+// left out.
+type Resampler struct {
+	df      *DataFrame
+	column  string
+	buckets []time.Time
+	groups  [][]int
+}
+
+func (df *DataFrame) Resample(column string, interval time.Duration) *Resampler {
+	if interval <= 0 {
+		panic(fmt.Errorf("%w: resample interval %v must be positive", ErrInvalidArgument, interval))
+	}
+
+	return df.resample(column, func(t time.Time) time.Time {
+		return t.Truncate(interval)
+	}, func(t time.Time) time.Time {
+		return t.Add(interval)
+	})
+}
+
+// ResampleCalendar buckets rows by calendar day, week starting Monday, month
+// or quarter in the given location.
+func (df *DataFrame) ResampleCalendar(column string, unit Calendar, loc *time.Location) *Resampler {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return df.resample(column, func(t time.Time) time.Time {
+		t = t.In(loc)
+		y, m, d := t.Date()
+
+		switch unit {
+		case Weekly:
+			d -= (int(t.Weekday()) + 6) % 7
+		case Monthly:
+			d = 1
+		case Quarterly:
+			m, d = (m-1)/3*3+1, 1
+		}
+
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	}, func(t time.Time) time.Time {
+		y, m, d := t.Date()
+
+		switch unit {
+		case Weekly:
+			d += 7
+		case Monthly:
+			m++
+		case Quarterly:
+			m += 3
+		default:
+			d++
+		}
+
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	})
+}
+
+func (r *Resampler) Len() int {
+	return len(r.buckets)
+}
+
+func (r *Resampler) Agg(aggs ...Aggregation) *DataFrame {
+	out := New()
+	out.AddColumn(r.column, NewTime(r.buckets...))
+	aggregate(out, r.df, r.groups, aggs)
+	return out
+}
+
+func (df *DataFrame) resample(
+	column string,
+	floor func(time.Time) time.Time,
+	next func(time.Time) time.Time,
+) *Resampler {
+	col, ok := df.Column(column).(*Time)
+	if !ok {
+		panic(fmt.Errorf("%w: resample requires a Time column", ErrTypeMismatch))
+	}
+
+	r := &Resampler{df: df, column: column}
+	if col.Len() == col.NullCount() {
+		return r
+	}
+
+	first, last := floor(col.Min()), floor(col.Max())
+
+	index := map[timeKey]int{}
+	for t := first; !t.After(last); t = next(t) {
+		index[timeKey{t.Unix(), t.Nanosecond()}] = len(r.buckets)
+		r.buckets = append(r.buckets, t)
+	}
+
+	r.groups = make([][]int, len(r.buckets))
+	for i, t := range col.data {
+		if col.nulls.isNull(i) {
+			continue
+		}
+
+		b := floor(t)
+		k := index[timeKey{b.Unix(), b.Nanosecond()}]
+		r.groups[k] = append(r.groups[k], i)
+	}
+
+	return r
+}
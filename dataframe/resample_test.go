@@ -0,0 +1,84 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestResample(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	df := dataframe.New()
+	df.AddColumn("timestamp", dataframe.NewTime(
+		start.Add(10*time.Minute),
+		start.Add(50*time.Minute),
+		start.Add(3*time.Hour+5*time.Minute),
+	))
+	df.AddColumn("value", dataframe.NewInt(1, 2, 4))
+	df.AppendRow(nil, 100)
+
+	out := df.Resample("timestamp", time.Hour).Agg(
+		dataframe.Aggregation{Column: "value", Func: dataframe.Sum},
+		dataframe.Aggregation{Column: "value", Func: dataframe.Count, Name: "count"},
+	)
+
+	if out.Len() != 4 {
+		t.Fatalf("expected 4 buckets, got %d", out.Len())
+	}
+
+	buckets := out.Column("timestamp").(*dataframe.Time)
+	for i := 0; i < 4; i++ {
+		want := start.Add(time.Duration(i) * time.Hour)
+		if got := buckets.Index(i); got != want {
+			t.Errorf("bucket %d: expected %v, got %v", i, want, got)
+		}
+	}
+
+	assertColumn(t, "sum", out.Column("value"), []any{int64(3), nil, nil, int64(4)})
+	assertColumn(t, "count", out.Column("count"), []any{int64(2), nil, nil, int64(1)})
+
+	y := out.Floats("value")
+	if y[0] != 3 || !math.IsNaN(y[1]) || y[3] != 4 {
+		t.Errorf("unexpected plot values %v", y)
+	}
+}
+
+func TestResampleCalendar(t *testing.T) {
+	loc := time.FixedZone("UTC+10", 10*60*60)
+
+	df := dataframe.New()
+	df.AddColumn("timestamp", dataframe.NewTime(
+		// Sunday 20:00 UTC is Monday 06:00 in loc
+		time.Date(2024, 3, 31, 20, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 3, 0, 0, 0, 0, loc),
+		time.Date(2024, 4, 21, 0, 0, 0, 0, loc),
+		time.Date(2024, 7, 1, 0, 0, 0, 0, loc),
+	))
+	df.AddColumn("value", dataframe.NewFloat(1, 2, 3, 4))
+
+	sum := dataframe.Aggregation{Column: "value", Func: dataframe.Sum}
+
+	weekly := df.ResampleCalendar("timestamp", dataframe.Weekly, loc).Agg(sum)
+	first := weekly.Column("timestamp").Index(0).(time.Time)
+	if !first.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, loc)) || first.Weekday() != time.Monday {
+		t.Errorf("expected week of Monday 1 April, got %v", first)
+	}
+	assertColumn(t, "weekly", weekly.Column("value"),
+		[]any{3.0, nil, 3.0, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 4.0})
+
+	monthly := df.ResampleCalendar("timestamp", dataframe.Monthly, loc).Agg(sum)
+	assertColumn(t, "monthly", monthly.Column("value"), []any{6.0, nil, nil, 4.0})
+
+	quarterly := df.ResampleCalendar("timestamp", dataframe.Quarterly, loc).Agg(sum)
+	assertColumn(t, "quarterly", quarterly.Column("value"), []any{6.0, 4.0})
+	if got := quarterly.Column("timestamp").Index(1).(time.Time); got.Month() != time.July {
+		t.Errorf("expected second quarter to start in July, got %v", got)
+	}
+
+	daily := df.ResampleCalendar("timestamp", dataframe.Daily, loc)
+	if daily.Len() != 92 {
+		t.Errorf("expected 92 days, got %d", daily.Len())
+	}
+}
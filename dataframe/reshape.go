@@ -0,0 +1,285 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// Pivot spreads the distinct values of columns into new columns, one row
+// per distinct value of index, reducing each cell with aggFunc. Rows and
+// columns keep the order in which their keys first appear, and cells
+// without any rows are null.
+func (df *DataFrame) Pivot(index, columns, values string, aggFunc Reducer) *DataFrame {
+	return df.pivot(index, columns, df.Column(values), aggFunc)
+}
+
+func (df *DataFrame) Crosstab(a, b string) *DataFrame {
+	out := df.pivot(a, b, df.Column(a), func(col IColumn) any {
+		return int64(col.Len())
+	})
+
+	fill := map[string]any{}
+	for _, name := range out.headers[1:] {
+		fill[name] = int64(0)
+	}
+	out.FillNA(fill)
+
+	return out
+}
+
+func (df *DataFrame) pivot(index, columns string, values IColumn, aggFunc Reducer) *DataFrame {
+	indexCol := df.Column(index)
+	columnsCol := df.Column(columns)
+
+	rowCodes := factorize(indexCol)
+	colCodes := factorize(columnsCol)
+
+	rowFirst, colFirst := []int{}, []int{}
+	for i := 0; i < df.rowCount; i++ {
+		if rowCodes[i] == len(rowFirst) {
+			rowFirst = append(rowFirst, i)
+		}
+		if colCodes[i] == len(colFirst) {
+			colFirst = append(colFirst, i)
+		}
+	}
+
+	cells := make([][]any, len(colFirst))
+	for c := range cells {
+		cells[c] = make([]any, len(rowFirst))
+	}
+
+	for _, rows := range groupRows([]IColumn{indexCol, columnsCol}, df.rowCount) {
+		r, c := rowCodes[rows[0]], colCodes[rows[0]]
+		cells[c][r] = aggFunc(values.Take(rows))
+	}
+
+	out := New()
+	out.AddColumn(index, indexCol.Take(rowFirst))
+	out.labels = index
+
+	for c, row := range colFirst {
+		name := label(columnsCol.Index(row))
+		if _, ok := out.index[name]; ok {
+			panic(fmt.Errorf("%w: duplicate column %q", ErrInvalidArgument, name))
+		}
+		out.AddColumn(name, valuesColumn(cells[c], values))
+	}
+
+	return out
+}
+
+func (df *DataFrame) Melt(idVars, valueVars []string) *DataFrame {
+	if len(valueVars) == 0 {
+		isID := map[string]bool{}
+		for _, name := range idVars {
+			isID[name] = true
+		}
+		for _, name := range df.headers {
+			if !isID[name] {
+				valueVars = append(valueVars, name)
+			}
+		}
+	}
+
+	sources := make([]IColumn, len(valueVars))
+	for i, name := range valueVars {
+		sources[i] = df.Column(name)
+	}
+
+	rows := make([]int, 0, df.rowCount*len(valueVars))
+	for range valueVars {
+		for i := 0; i < df.rowCount; i++ {
+			rows = append(rows, i)
+		}
+	}
+
+	out := New()
+	for _, name := range idVars {
+		out.AddColumn(name, df.Column(name).Take(rows))
+	}
+
+	variable := NewString()
+	value := meltColumn(sources)
+	for k, col := range sources {
+		for i := 0; i < df.rowCount; i++ {
+			variable.Append(valueVars[k])
+
+			n := value.Len()
+			value.Extend(n + 1)
+			if v := col.Index(i); v != nil {
+				if _, ok := value.(*String); ok {
+					v = formatText(v)
+				}
+				value.Set(n, coerce(value, v))
+			}
+		}
+	}
+
+	out.AddColumn("variable", variable)
+	out.AddColumn("value", value)
+	return out
+}
+
+func meltColumn(sources []IColumn) IColumn {
+	if len(sources) == 0 {
+		return NewString()
+	}
+
+	same, numeric := true, true
+	for _, col := range sources {
+		switch col.(type) {
+		case *Int, *Float:
+		default:
+			numeric = false
+		}
+		if reflect.TypeOf(col) != reflect.TypeOf(sources[0]) {
+			same = false
+		}
+	}
+
+	switch {
+	case same:
+		return sources[0].New()
+	case numeric:
+		return NewFloat()
+	default:
+		return NewString()
+	}
+}
+
+// Explode turns every element of a List column into its own row, repeating
+// the other columns. Null and empty lists keep a single row with a null.
+func (df *DataFrame) Explode(column string) *DataFrame {
+	list, ok := df.Column(column).(*List)
+	if !ok {
+		panic(fmt.Errorf("%w: explode requires a List column", ErrTypeMismatch))
+	}
+
+	rows, elements := []int{}, []int{}
+	for i := 0; i < df.rowCount; i++ {
+		start, end := list.offsets[i], list.offsets[i+1]
+		if start == end {
+			rows = append(rows, i)
+			elements = append(elements, -1)
+			continue
+		}
+
+		for j := start; j < end; j++ {
+			rows = append(rows, i)
+			elements = append(elements, j)
+		}
+	}
+
+	out := df.Take(rows)
+	out.data[out.index[column]] = list.values.Take(elements)
+	return out
+}
+
+// Unnest replaces a Struct column with one column per field, named
+// column.field and placed where the struct was.
+func (df *DataFrame) Unnest(column string) *DataFrame {
+	st, ok := df.Column(column).(*Struct)
+	if !ok {
+		panic(fmt.Errorf("%w: unnest requires a Struct column", ErrTypeMismatch))
+	}
+
+	out := New()
+	for i, name := range df.headers {
+		if name != column {
+			out.AddColumn(name, df.data[i].Clone())
+			continue
+		}
+
+		for k, field := range st.fields.headers {
+			out.AddColumn(column+"."+field, st.fields.data[k].Clone())
+		}
+	}
+
+	return out
+}
+
+// Matrix returns the given numeric columns as rows of float64, with nulls as
+// NaN, in the shape expected by plotter.Heatmap.SetData. Without columns it
+// uses the value columns of a Pivot or Crosstab, leaving out the index
+// column whatever its type, and every numeric column of other frames.
+func (df *DataFrame) Matrix(columns ...string) [][]float64 {
+	if len(columns) == 0 && df.labels != "" {
+		for _, name := range df.headers {
+			if name != df.labels {
+				columns = append(columns, name)
+			}
+		}
+	} else if len(columns) == 0 {
+		for i, name := range df.headers {
+			switch df.data[i].(type) {
+			case *Int, *Float, *Int32, *Uint64, *Float32, *Decimal:
+				columns = append(columns, name)
+			}
+		}
+	}
+
+	cols := make([][]float64, len(columns))
+	for j, name := range columns {
+		cols[j] = df.Floats(name)
+	}
+
+	matrix := make([][]float64, df.rowCount)
+	for i := range matrix {
+		matrix[i] = make([]float64, len(cols))
+		for j, col := range cols {
+			matrix[i][j] = col[i]
+		}
+	}
+
+	return matrix
+}
+
+// Floats returns a numeric or Time column as float64 with nulls as NaN, ready
+// to pass to plotter.LinePlot.AddSeries. Times are Unix seconds.
+func (df *DataFrame) Floats(column string) []float64 {
+	col := df.Column(column)
+
+	values := make([]float64, df.rowCount)
+	for i := range values {
+		switch v := convert([]any{col.Index(i)})[0].(type) {
+		case nil:
+			values[i] = math.NaN()
+		case int64:
+			values[i] = float64(v)
+		case uint64:
+			values[i] = float64(v)
+		case float64:
+			values[i] = v
+		case Fixed:
+			values[i] = v.Float64()
+		case time.Time:
+			values[i] = float64(v.UnixNano()) / 1e9
+		default:
+			panic(fmt.Errorf("%w: column %q is not numeric", ErrTypeMismatch, column))
+		}
+	}
+
+	return values
+}
+
+func (df *DataFrame) Labels(column string) []string {
+	col := df.Column(column)
+
+	labels := make([]string, df.rowCount)
+	for i := range labels {
+		labels[i] = label(col.Index(i))
+	}
+
+	return labels
+}
+
+func label(value any) string {
+	if value == nil {
+		return "null"
+	}
+
+	return formatText(value)
+}
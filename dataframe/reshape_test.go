@@ -0,0 +1,87 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"go-numeric/plotter"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestPivot(t *testing.T) {
+	df := newSales()
+
+	wide := df.Pivot("region", "product", "units", dataframe.Sum)
+	if h := strings.Join(wide.Headers(), ","); h != "region,a,b" {
+		t.Fatalf("unexpected headers %s", h)
+	}
+
+	matrix := wide.Matrix("a", "b")
+	if matrix[0][0] != 6 || matrix[0][1] != 3 || matrix[1][0] != 6 || !math.IsNaN(matrix[1][1]) {
+		t.Errorf("unexpected matrix %v", matrix)
+	}
+	if all := wide.Matrix(); len(all[0]) != 2 || all[0][1] != 3 {
+		t.Errorf("expected the default matrix to skip the index column, got %v", all)
+	}
+
+	byUnits := df.Pivot("units", "product", "price", dataframe.Sum)
+	if all := byUnits.Matrix(); len(all[0]) != 2 || all[0][0] != 1.5 {
+		t.Errorf("expected the default matrix to skip a numeric index column, got %v", all)
+	}
+
+	if v := strings.Join(wide.Labels("region"), ","); v != "north,south" {
+		t.Errorf("unexpected labels %s", v)
+	}
+
+	heatmap := plotter.NewHeatmap(nil)
+	heatmap.SetData(matrix)
+	heatmap.SetXLabels(wide.Headers()[1:])
+	heatmap.SetYLabels(wide.Labels("region"))
+}
+
+func TestCrosstab(t *testing.T) {
+	df := newSales()
+
+	counts := df.Crosstab("region", "promo")
+	if h := strings.Join(counts.Headers(), ","); h != "region,true,false" {
+		t.Fatalf("unexpected headers %s", h)
+	}
+
+	want := [][]any{
+		{"north", int64(2), int64(1)},
+		{"south", int64(0), int64(2)},
+	}
+	for i, row := range want {
+		for j, v := range row {
+			if got := counts.Row(i)[j]; got != v {
+				t.Errorf("row %d col %d: expected %v, got %v", i, j, v, got)
+			}
+		}
+	}
+}
+
+func TestMelt(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewString("a", "b"))
+	df.AddColumn("x", dataframe.NewInt(1, 2))
+	df.AddColumn("y", dataframe.NewFloat(0.5))
+
+	long := df.Melt([]string{"id"}, nil)
+	if h := strings.Join(long.Headers(), ","); h != "id,variable,value" {
+		t.Fatalf("unexpected headers %s", h)
+	}
+	if long.Len() != 4 {
+		t.Fatalf("expected 4 rows, got %d", long.Len())
+	}
+
+	if _, ok := long.Column("value").(*dataframe.Float); !ok {
+		t.Errorf("expected Int and Float to melt into Float, got %T", long.Column("value"))
+	}
+
+	want := []any{"b", "y", nil}
+	for j, v := range want {
+		if got := long.Row(3)[j]; got != v {
+			t.Errorf("col %d: expected %v, got %v", j, v, got)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+// Field describes one column of a Schema. Type is a prototype column such as
+// NewInt() or NewDecimal(2), the same way CSVOptions.Schema names types. The
+// constraints apply to non-null values and are skipped when left unset.
+type Field struct {
+	Name     string
+	Type     IColumn
+	Nullable bool
+
+	Min     any
+	Max     any
+	Allowed []any
+	Pattern *regexp.Regexp
+	Unique  bool
+
+	// Layout parses strings when casting to a Time column, defaulting to the
+	// layouts LoadCSV tries.
+	Layout string
+}
+
+type Schema []Field
+
+// Field returns the field with the given name.
+func (s Schema) Field(name string) (Field, bool) {
+	for _, f := range s {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// Schema describes the columns of the frame. A column is nullable when it
+// currently holds nulls, so a frame always passes its own schema.
+func (df *DataFrame) Schema() Schema {
+	s := make(Schema, len(df.headers))
+	for i, name := range df.headers {
+		s[i] = Field{
+			Name:     name,
+			Type:     df.data[i].New(),
+			Nullable: df.data[i].NullCount() > 0,
+		}
+	}
+	return s
+}
+
+type Rule int
+
+const (
+	RuleMissing Rule = iota
+	RuleType
+	RuleNull
+	RuleMin
+	RuleMax
+	RuleAllowed
+	RulePattern
+	RuleUnique
+	RuleCast
+)
+
+func (r Rule) String() string {
+	switch r {
+	case RuleMissing:
+		return "missing column"
+	case RuleType:
+		return "wrong type"
+	case RuleNull:
+		return "null value"
+	case RuleMin:
+		return "below minimum"
+	case RuleMax:
+		return "above maximum"
+	case RuleAllowed:
+		return "value not allowed"
+	case RulePattern:
+		return "pattern mismatch"
+	case RuleUnique:
+		return "duplicate value"
+	case RuleCast:
+		return "cast failed"
+	default:
+		return fmt.Sprintf("Rule(%d)", int(r))
+	}
+}
+
+// Violation is a value that breaks a rule of a schema. Row is -1 when the
+// rule concerns the whole column, such as a missing column or a wrong type.
+type Violation struct {
+	Row    int
+	Column string
+	Rule   Rule
+	Value  any
+}
+
+func (v Violation) Error() string {
+	if v.Row < 0 {
+		return fmt.Sprintf("column %q: %v", v.Column, v.Rule)
+	}
+	return fmt.Sprintf("row %d, column %q: %v %s", v.Row, v.Column, v.Rule, formatValue(v.Value))
+}
+
+// Report lists violations ordered by row, column level violations first.
+type Report struct {
+	Violations []Violation
+}
+
+func (r *Report) Valid() bool {
+	return len(r.Violations) == 0
+}
+
+// Rows returns the distinct rows with at least one violation, so they can be
+// inspected with Take or dropped.
+func (r *Report) Rows() []int {
+	rows := []int{}
+	for _, v := range r.Violations {
+		if v.Row >= 0 && (len(rows) == 0 || rows[len(rows)-1] != v.Row) {
+			rows = append(rows, v.Row)
+		}
+	}
+	return rows
+}
+
+// Err summarises the report as an error wrapping ErrSchemaViolation, or nil
+// when it is valid.
+func (r *Report) Err() error {
+	if r.Valid() {
+		return nil
+	}
+	return fmt.Errorf("%w: %d violations, first %v", ErrSchemaViolation, len(r.Violations), r.Violations[0])
+}
+
+func (r *Report) add(row int, column string, rule Rule, value any) {
+	r.Violations = append(r.Violations, Violation{Row: row, Column: column, Rule: rule, Value: value})
+}
+
+func (r *Report) sort() {
+	sort.SliceStable(r.Violations, func(i, j int) bool {
+		return r.Violations[i].Row < r.Violations[j].Row
+	})
+}
+
+// Validate checks every field of schema against the frame. Columns the
+// schema does not name are ignored.
+func (df *DataFrame) Validate(schema Schema) *Report {
+	report := &Report{}
+
+	for _, f := range schema {
+		idx, ok := df.index[f.Name]
+		if !ok {
+			report.add(-1, f.Name, RuleMissing, nil)
+			continue
+		}
+
+		col := df.data[idx]
+		if f.Type != nil && !sameType(col, f.Type) {
+			report.add(-1, f.Name, RuleType, fmt.Sprintf("%T", col))
+			continue
+		}
+
+		df.validateField(f, col, report)
+	}
+
+	report.sort()
+	return report
+}
+
+func (df *DataFrame) validateField(f Field, col IColumn, report *Report) {
+	// a constraint that does not fit the column is reported rather than
+	// checked
+	bound := func(v any) (any, bool) {
+		value, err := convertTo(col, v)
+		if err != nil {
+			report.add(-1, f.Name, RuleType, err.Error())
+		}
+		return value, err == nil
+	}
+
+	var low, high, allowed filter
+	if v, ok := bound(f.Min); ok && v != nil {
+		low = &GTE{Column: f.Name, Value: v}
+	}
+	if v, ok := bound(f.Max); ok && v != nil {
+		high = &LTE{Column: f.Name, Value: v}
+	}
+	if f.Allowed != nil {
+		values := make([]filter, 0, len(f.Allowed))
+		for _, a := range f.Allowed {
+			if v, ok := bound(a); ok {
+				values = append(values, &EQ{Column: f.Name, Value: v})
+			}
+		}
+		allowed = OR(values...)
+	}
+
+	var codes []int
+	seen := map[int]struct{}{}
+	if f.Unique {
+		if err := catch(func() { codes = factorize(col) }); err != nil {
+			report.add(-1, f.Name, RuleType, err.Error())
+		}
+	}
+
+	for i := 0; i < df.rowCount; i++ {
+		if col.IsNull(i) {
+			if !f.Nullable {
+				report.add(i, f.Name, RuleNull, nil)
+			}
+			continue
+		}
+
+		value := col.Index(i)
+		if low != nil && !low.check(df, i) {
+			report.add(i, f.Name, RuleMin, value)
+		}
+		if high != nil && !high.check(df, i) {
+			report.add(i, f.Name, RuleMax, value)
+		}
+		if allowed != nil && !allowed.check(df, i) {
+			report.add(i, f.Name, RuleAllowed, value)
+		}
+		if f.Pattern != nil && !f.Pattern.MatchString(formatText(value)) {
+			report.add(i, f.Name, RulePattern, value)
+		}
+		if codes != nil {
+			if _, dup := seen[codes[i]]; dup {
+				report.add(i, f.Name, RuleUnique, value)
+			}
+			seen[codes[i]] = struct{}{}
+		}
+	}
+}
+
+// Cast returns a copy of the frame with each column named by schema
+// converted to the field's type. Strings are parsed like LoadCSV does, other
+// values are formatted as text for String columns and converted where the
+// conversion is exact otherwise. Values that fail to convert become null and
+// are listed in the report, as are fields missing from the frame. Columns
+// the schema does not name are copied as they are.
+func (df *DataFrame) Cast(schema Schema) (*DataFrame, *Report) {
+	report := &Report{}
+	fields := map[string]Field{}
+	for _, f := range schema {
+		if _, ok := df.index[f.Name]; !ok {
+			report.add(-1, f.Name, RuleMissing, nil)
+			continue
+		}
+		fields[f.Name] = f
+	}
+
+	out := New()
+	for i, name := range df.headers {
+		src := df.data[i]
+		f, ok := fields[name]
+		if !ok || f.Type == nil || sameType(src, f.Type) {
+			out.AddColumn(name, src.Clone())
+			continue
+		}
+
+		layouts := defaultTimeLayouts
+		if f.Layout != "" {
+			layouts = []string{f.Layout}
+		}
+
+		col := f.Type.New()
+		for k := 0; k < df.rowCount; k++ {
+			col.Extend(k + 1)
+			if src.IsNull(k) {
+				continue
+			}
+
+			// converting NaN or Inf to a Decimal panics with ErrOverflow
+			var v any
+			var castErr error
+			err := catch(func() { v, castErr = castValue(col, src.Index(k), layouts) })
+			if err == nil {
+				err = castErr
+			}
+			if err == nil {
+				err = catch(func() { col.Set(k, v) })
+			}
+			if err != nil {
+				report.add(k, name, RuleCast, src.Index(k))
+			}
+		}
+
+		out.AddColumn(name, col)
+	}
+
+	report.sort()
+	return out, report
+}
+
+func castValue(col IColumn, value any, layouts []string) (any, error) {
+	value = convert([]any{value})[0]
+
+	switch v := value.(type) {
+	case string:
+		if !stores(col, v) {
+			return parseText(col, v, layouts)
+		}
+	case float64:
+		switch col.(type) {
+		case *Int, *Int32, *Uint64:
+			if v != math.Trunc(v) || math.Abs(v) >= 1<<63 {
+				return nil, fmt.Errorf("%w: %v is not an integer", ErrTypeMismatch, v)
+			}
+			value = int64(v)
+		}
+	case Fixed:
+		switch col.(type) {
+		case *Float, *Float32:
+			value = v.Float64()
+		}
+	}
+
+	switch col.(type) {
+	case *String, *Categorical:
+		value = formatText(value)
+	}
+
+	return convertTo(col, value)
+}
+
+// sameType reports whether a and b are the same kind of column, including
+// the scale of Decimal columns.
+func sameType(a, b IColumn) bool {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+	if d, ok := a.(*Decimal); ok {
+		return d.scale == b.(*Decimal).scale
+	}
+	return true
+}
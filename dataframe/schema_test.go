@@ -0,0 +1,141 @@
+package dataframe_test
+
+import (
+	"errors"
+	"go-numeric/dataframe"
+	"math"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSchemaOfFrame(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewInt(1, 2))
+	df.AddColumn("price", dataframe.NewDecimal(2, 100, 250))
+	df.AppendRow(3, nil)
+
+	schema := df.Schema()
+	if len(schema) != 2 || schema[0].Name != "id" || schema[0].Nullable || !schema[1].Nullable {
+		t.Fatalf("unexpected schema %+v", schema)
+	}
+	if _, ok := schema[1].Type.(*dataframe.Decimal); !ok {
+		t.Errorf("expected Decimal prototype, got %T", schema[1].Type)
+	}
+	if r := df.Validate(schema); !r.Valid() {
+		t.Errorf("frame fails its own schema: %v", r.Violations)
+	}
+
+	if r := df.Validate(dataframe.Schema{{Name: "price", Type: dataframe.NewDecimal(4)}}); r.Valid() {
+		t.Error("expected a type violation for a different decimal scale")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewInt(1, 2, 2, 4))
+	df.AddColumn("age", dataframe.NewInt(30, -1, 45, 200))
+	df.AddColumn("country", dataframe.NewString("NZ", "AU", "XX", "NZ"))
+	df.AddColumn("email", dataframe.NewString("a@x.io", "b@x.io", "nope", "d@x.io"))
+	df.Column("email").Set(1, nil)
+
+	report := df.Validate(dataframe.Schema{
+		{Name: "id", Type: dataframe.NewInt(), Unique: true},
+		{Name: "age", Type: dataframe.NewInt(), Min: 0, Max: 150},
+		{Name: "country", Type: dataframe.NewString(), Allowed: []any{"NZ", "AU"}},
+		{Name: "email", Type: dataframe.NewString(), Pattern: regexp.MustCompile(`^[^@]+@[^@]+$`)},
+		{Name: "score", Type: dataframe.NewFloat()},
+	})
+
+	expected := []dataframe.Violation{
+		{Row: -1, Column: "score", Rule: dataframe.RuleMissing},
+		{Row: 1, Column: "age", Rule: dataframe.RuleMin, Value: int64(-1)},
+		{Row: 1, Column: "email", Rule: dataframe.RuleNull},
+		{Row: 2, Column: "id", Rule: dataframe.RuleUnique, Value: int64(2)},
+		{Row: 2, Column: "country", Rule: dataframe.RuleAllowed, Value: "XX"},
+		{Row: 2, Column: "email", Rule: dataframe.RulePattern, Value: "nope"},
+		{Row: 3, Column: "age", Rule: dataframe.RuleMax, Value: int64(200)},
+	}
+	if !slices.Equal(report.Violations, expected) {
+		t.Errorf("expected %v, got %v", expected, report.Violations)
+	}
+	if rows := report.Rows(); !slices.Equal(rows, []int{1, 2, 3}) {
+		t.Errorf("unexpected rows %v", rows)
+	}
+	if err := report.Err(); !errors.Is(err, dataframe.ErrSchemaViolation) {
+		t.Errorf("expected ErrSchemaViolation, got %v", err)
+	}
+
+	if r := df.Validate(dataframe.Schema{{Name: "age", Type: dataframe.NewFloat()}}); r.Violations[0].Rule != dataframe.RuleType {
+		t.Errorf("expected a type violation, got %v", r.Violations)
+	}
+}
+
+func TestCast(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewString("1", "2", "x"))
+	df.AddColumn("qty", dataframe.NewInt(3, 4, 5))
+	df.AddColumn("at", dataframe.NewString("2024-01-02", "", "2024-03-04"))
+	df.AddColumn("ratio", dataframe.NewFloat(1, 2.5, 3))
+	df.AddColumn("note", dataframe.NewString("a", "b", "c"))
+	df.Column("at").Set(1, nil)
+
+	out, report := df.Cast(dataframe.Schema{
+		{Name: "id", Type: dataframe.NewInt()},
+		{Name: "qty", Type: dataframe.NewFloat()},
+		{Name: "at", Type: dataframe.NewTime(), Layout: "2006-01-02"},
+		{Name: "ratio", Type: dataframe.NewInt()},
+		{Name: "missing", Type: dataframe.NewInt()},
+	})
+
+	assertColumn(t, "id", out.Column("id"), []any{int64(1), int64(2), nil})
+	assertColumn(t, "qty", out.Column("qty"), []any{3.0, 4.0, 5.0})
+	assertColumn(t, "at", out.Column("at"), []any{
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil,
+		time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+	})
+	assertColumn(t, "ratio", out.Column("ratio"), []any{int64(1), nil, int64(3)})
+	assertColumn(t, "note", out.Column("note"), []any{"a", "b", "c"})
+
+	expected := []dataframe.Violation{
+		{Row: -1, Column: "missing", Rule: dataframe.RuleMissing},
+		{Row: 1, Column: "ratio", Rule: dataframe.RuleCast, Value: 2.5},
+		{Row: 2, Column: "id", Rule: dataframe.RuleCast, Value: "x"},
+	}
+	if !slices.Equal(report.Violations, expected) {
+		t.Errorf("expected %v, got %v", expected, report.Violations)
+	}
+
+	back, report := out.Cast(dataframe.Schema{{Name: "qty", Type: dataframe.NewString()}})
+	if !report.Valid() {
+		t.Errorf("unexpected violations %v", report.Violations)
+	}
+	assertColumn(t, "qty", back.Column("qty"), []any{"3.0", "4.0", "5.0"})
+}
+
+func TestSchemaBadValues(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("price", dataframe.NewFloat(1.25, math.NaN(), math.Inf(1)))
+	df.AddColumn("name", dataframe.NewString("a", "b", "c"))
+
+	out, report := df.Cast(dataframe.Schema{{Name: "price", Type: dataframe.NewDecimal(2)}})
+	assertColumn(t, "price", out.Column("price"), []any{dataframe.Fixed{Units: 125, Scale: 2}, nil, nil})
+	if rows := report.Rows(); !slices.Equal(rows, []int{1, 2}) {
+		t.Errorf("expected NaN and Inf to fail the cast, got %v", report.Violations)
+	}
+
+	report = df.Validate(dataframe.Schema{{Name: "name", Min: 5, Allowed: []any{"a", 1}}})
+	types := 0
+	for _, v := range report.Violations {
+		if v.Rule == dataframe.RuleType {
+			types++
+		}
+	}
+	if types != 2 {
+		t.Errorf("expected 2 type violations for mistyped constraints, got %v", report.Violations)
+	}
+	if rows := report.Rows(); !slices.Equal(rows, []int{1, 2}) {
+		t.Errorf("expected the allowed values that fit to be checked, got %v", report.Violations)
+	}
+}
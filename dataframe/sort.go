@@ -0,0 +1,117 @@
+package dataframe
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type SortKey struct {
+	Column     string
+	Descending bool
+	NullsFirst bool
+}
+
+func (df *DataFrame) Sort(keys ...SortKey) {
+	order := df.Argsort(keys...)
+	for i, col := range df.data {
+		df.data[i] = col.Take(order)
+	}
+}
+
+// Argsort returns the row permutation that stably sorts the frame by the
+// given keys. Nulls sort last unless the key asks for them first.
+func (df *DataFrame) Argsort(keys ...SortKey) []int {
+	comparators := make([]func(a, b int) int, len(keys))
+	for k, key := range keys {
+		comparators[k] = comparator(df.Column(key.Column), key)
+	}
+
+	order := make([]int, df.rowCount)
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, func(a, b int) int {
+		for _, compare := range comparators {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	return order
+}
+
+func comparator(col IColumn, key SortKey) func(a, b int) int {
+	switch c := col.(type) {
+	case *Int:
+		return compareValues(c.data, &c.nulls, key, cmp.Compare[int64])
+	case *Float:
+		return compareValues(c.data, &c.nulls, key, cmp.Compare[float64])
+	case *String:
+		return compareValues(c.data, &c.nulls, key, strings.Compare)
+	case *Time:
+		return compareValues(c.data, &c.nulls, key, time.Time.Compare)
+	case *Int32:
+		return compareValues(c.data, &c.nulls, key, cmp.Compare[int32])
+	case *Uint64:
+		return compareValues(c.data, &c.nulls, key, cmp.Compare[uint64])
+	case *Float32:
+		return compareValues(c.data, &c.nulls, key, cmp.Compare[float32])
+	case *Decimal:
+		return compareValues(c.data, &c.nulls, key, cmp.Compare[int64])
+	case *Categorical:
+		ranks := c.ranks()
+		return compareValues(c.data, &c.nulls, key, func(a, b int32) int {
+			return cmp.Compare(ranks[a], ranks[b])
+		})
+	case *Bool:
+		return compareValues(c.data, &c.nulls, key, func(a, b bool) int {
+			switch {
+			case a == b:
+				return 0
+			case !a:
+				return -1
+			default:
+				return 1
+			}
+		})
+	default:
+		panic(fmt.Errorf("%w: cannot sort by %T", ErrTypeMismatch, col))
+	}
+}
+
+func compareValues[T any](
+	data []T,
+	nulls *bitmap,
+	key SortKey,
+	compare func(a, b T) int,
+) func(a, b int) int {
+	nullOrder := 1
+	if key.NullsFirst {
+		nullOrder = -1
+	}
+
+	return func(a, b int) int {
+		if nulls.count > 0 {
+			an, bn := nulls.isNull(a), nulls.isNull(b)
+			switch {
+			case an && bn:
+				return 0
+			case an:
+				return nullOrder
+			case bn:
+				return -nullOrder
+			}
+		}
+
+		if key.Descending {
+			return compare(data[b], data[a])
+		}
+		return compare(data[a], data[b])
+	}
+}
@@ -0,0 +1,52 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"slices"
+	"testing"
+)
+
+func TestArgsort(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("region", dataframe.NewString("b", "a", "b", "a", "b"))
+	df.AddColumn("units", dataframe.NewInt(1, 2, 3))
+	df.AppendRow("a", nil)
+
+	order := df.Argsort(
+		dataframe.SortKey{Column: "region"},
+		dataframe.SortKey{Column: "units", Descending: true},
+	)
+	if want := []int{1, 3, 5, 2, 0, 4}; !slices.Equal(order, want) {
+		t.Errorf("expected %v, got %v", want, order)
+	}
+
+	order = df.Argsort(
+		dataframe.SortKey{Column: "region", Descending: true},
+		dataframe.SortKey{Column: "units", NullsFirst: true},
+	)
+	if want := []int{4, 0, 2, 3, 5, 1}; !slices.Equal(order, want) {
+		t.Errorf("expected %v, got %v", want, order)
+	}
+}
+
+func TestTake(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("id", dataframe.NewInt(1, 2, 3))
+	df.AddColumn("name", dataframe.NewString("x", "y"))
+
+	res := df.Take([]int{2, 0, -1})
+	if res.Len() != 3 {
+		t.Fatalf("expected 3 rows, got %d", res.Len())
+	}
+	if v := res.Column("id").Index(0); v != int64(3) {
+		t.Errorf("expected 3, got %v", v)
+	}
+	if !res.Column("name").IsNull(0) || !res.Column("id").IsNull(2) {
+		t.Error("expected nulls to be carried and negative indices to be null")
+	}
+
+	df.Sort(dataframe.SortKey{Column: "name", NullsFirst: true})
+	if v := df.Column("id").(*dataframe.Int).Data(); !slices.Equal(v, []int64{3, 1, 2}) {
+		t.Errorf("unexpected order %v", v)
+	}
+}
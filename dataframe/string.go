@@ -1,87 +1,205 @@
 package dataframe
 
 import (
-	"slices"
-	"sort"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 type String struct {
-	data []string
+	Column[string]
 }
 
 func NewString(data ...string) *String {
-	return &String{
-		data: data,
-	}
+	return &String{Column[string]{data: data}}
 }
 
 func (col *String) New() IColumn {
 	return &String{}
 }
 
-func (col *String) Len() int {
-	return len(col.data)
+func (col *String) Clone() IColumn {
+	return &String{col.clone()}
 }
 
-func (col *String) Data() []string {
-	return slices.Clone(col.data)
+func (col *String) Take(indices []int) IColumn {
+	return &String{col.take(indices)}
 }
 
-func (col *String) Extend(length int) {
-	diff := length - len(col.data)
-	if diff > 0 {
-		col.data = append(col.data, make([]string, diff)...)
-	}
+func (col *String) SortBy(asc bool) {
+	sortValues(col.data, &col.nulls, func(a, b string) bool {
+		if asc {
+			return a < b
+		}
+		return a > b
+	})
 }
 
-func (col *String) Index(idx int) any {
-	return col.data[idx]
+// ParseTime parses every non-null value with layout, interpreting times
+// without a zone in loc, or UTC if loc is nil. Empty strings become null.
+func (col *String) ParseTime(layout string, loc *time.Location) (*Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	out := &Time{Column[time.Time]{data: make([]time.Time, len(col.data))}}
+	for i, s := range col.data {
+		if col.nulls.isNull(i) || s == "" {
+			out.nulls.set(i, true)
+			continue
+		}
+
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+		out.data[i] = t
+	}
+
+	return out, nil
 }
 
-func (col *String) Clone() IColumn {
-	newData := make([]string, len(col.data))
-	copy(newData, col.data)
-	return &String{data: newData}
+func (col *String) Contains(substr string) *Bool {
+	return col.test(func(s string) bool { return strings.Contains(s, substr) })
 }
 
-func (col *String) DeleteRow(index int) {
-	col.data = append(col.data[:index], col.data[index+1:]...)
+func (col *String) HasPrefix(prefix string) *Bool {
+	return col.test(func(s string) bool { return strings.HasPrefix(s, prefix) })
 }
 
-func (col *String) Set(index int, value any) {
-	col.data[index] = value.(string)
+func (col *String) HasSuffix(suffix string) *Bool {
+	return col.test(func(s string) bool { return strings.HasSuffix(s, suffix) })
 }
 
-func (col *String) Append(value string) {
-	col.data = append(col.data, value)
+func (col *String) Match(re *regexp.Regexp) *Bool {
+	return col.test(re.MatchString)
 }
 
-func (col *String) Head() (string, bool) {
-	if len(col.data) == 0 {
-		return "", false
+// Extract returns the given capture group of the first match of re, with 0
+// being the whole match. Rows that do not match are null.
+func (col *String) Extract(re *regexp.Regexp, group int) *String {
+	out := &String{Column[string]{data: make([]string, len(col.data))}}
+	for i, s := range col.data {
+		var m []string
+		if !col.nulls.isNull(i) {
+			m = re.FindStringSubmatch(s)
+		}
+		if group >= len(m) {
+			out.nulls.set(i, true)
+			continue
+		}
+		out.data[i] = m[group]
 	}
-	return col.data[0], true
+	return out
 }
 
-func (col *String) Tail() []string {
-	if len(col.data) <= 1 {
-		return nil
-	}
-	return col.data[1:]
+// Replace replaces every match of re with repl, which may refer to capture
+// groups as in regexp.Regexp.ReplaceAllString.
+func (col *String) Replace(re *regexp.Regexp, repl string) *String {
+	return col.apply(func(s string) string { return re.ReplaceAllString(s, repl) })
 }
 
-func (col *String) Last() (string, bool) {
-	if len(col.data) == 0 {
-		return "", false
+// Split splits every value around sep into n columns following
+// strings.SplitN. Values with fewer parts are padded with nulls and the last
+// column holds the unsplit remainder. A negative n splits on every sep, with
+// as many columns as the value with the most parts, and zero gives none.
+func (col *String) Split(sep string, n int) []*String {
+	parts := make([][]string, len(col.data))
+	width := max(n, 0)
+	for i, s := range col.data {
+		if !col.nulls.isNull(i) {
+			parts[i] = strings.SplitN(s, sep, n)
+		}
+		if n < 0 {
+			width = max(width, len(parts[i]))
+		}
 	}
-	return col.data[len(col.data)-1], true
-}
 
-func (col *String) SortBy(asc bool) {
-	sort.Slice(col.data, func(i, j int) bool {
-		if asc {
-			return col.data[i] < col.data[j]
+	out := make([]*String, width)
+	for k := range out {
+		out[k] = &String{Column[string]{data: make([]string, len(col.data))}}
+	}
+
+	for i := range col.data {
+		for k, c := range out {
+			if k >= len(parts[i]) {
+				c.nulls.set(i, true)
+				continue
+			}
+			c.data[i] = parts[i][k]
 		}
-		return col.data[i] > col.data[j]
+	}
+
+	return out
+}
+
+func (col *String) Lower() *String {
+	return col.apply(strings.ToLower)
+}
+
+func (col *String) Upper() *String {
+	return col.apply(strings.ToUpper)
+}
+
+func (col *String) Trim() *String {
+	return col.apply(strings.TrimSpace)
+}
+
+// PadLeft pads every value on the left with fill up to width characters.
+// Longer values are kept as they are.
+func (col *String) PadLeft(width int, fill rune) *String {
+	return col.apply(func(s string) string {
+		return strings.Repeat(string(fill), padding(s, width)) + s
+	})
+}
+
+func (col *String) PadRight(width int, fill rune) *String {
+	return col.apply(func(s string) string {
+		return s + strings.Repeat(string(fill), padding(s, width))
+	})
+}
+
+// Pad centres every value within width characters, putting the odd fill
+// character on the right.
+func (col *String) Pad(width int, fill rune) *String {
+	return col.apply(func(s string) string {
+		n := padding(s, width)
+		return strings.Repeat(string(fill), n/2) + s + strings.Repeat(string(fill), n-n/2)
 	})
 }
+
+func padding(s string, width int) int {
+	return max(width-utf8.RuneCountInString(s), 0)
+}
+
+// Lengths returns the length of every value in characters rather than
+// bytes. It is not named Len since that reports the number of rows.
+func (col *String) Lengths() *Int {
+	data, nulls := mapValues(col.data, &col.nulls, func(s string) int64 {
+		return int64(utf8.RuneCountInString(s))
+	})
+	return &Int{Column[int64]{data: data, nulls: nulls}}
+}
+
+// Substr returns up to length characters starting at character start,
+// clamped to the bounds of each value.
+func (col *String) Substr(start, length int) *String {
+	return col.apply(func(s string) string {
+		r := []rune(s)
+		from := min(max(start, 0), len(r))
+		to := min(from+max(length, 0), len(r))
+		return string(r[from:to])
+	})
+}
+
+func (col *String) test(f func(string) bool) *Bool {
+	data, nulls := mapValues(col.data, &col.nulls, f)
+	return &Bool{Column[bool]{data: data, nulls: nulls}}
+}
+
+func (col *String) apply(f func(string) string) *String {
+	data, nulls := mapValues(col.data, &col.nulls, f)
+	return &String{Column[string]{data: data, nulls: nulls}}
+}
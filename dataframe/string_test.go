@@ -0,0 +1,82 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"regexp"
+	"testing"
+)
+
+func TestStringOps(t *testing.T) {
+	col := dataframe.NewString("  Alice Smith ", "bob-jones", "Ünal")
+	col.AppendNull()
+
+	assertColumn(t, "contains", col.Contains("Smith"), []any{true, false, false, nil})
+	assertColumn(t, "prefix", col.HasPrefix("bob"), []any{false, true, false, nil})
+	assertColumn(t, "lower", col.Trim().Lower(), []any{"alice smith", "bob-jones", "ünal", nil})
+	assertColumn(t, "upper", col.Upper().Substr(0, 3), []any{"  A", "BOB", "ÜNA", nil})
+	assertColumn(t, "lengths", col.Lengths(), []any{int64(14), int64(9), int64(4), nil})
+	assertColumn(t, "substr", col.Substr(2, 100), []any{"Alice Smith ", "b-jones", "al", nil})
+
+	word := regexp.MustCompile(`([a-z]+)-([a-z]+)`)
+	assertColumn(t, "match", col.Match(word), []any{false, true, false, nil})
+	assertColumn(t, "extract", col.Extract(word, 2), []any{nil, "jones", nil, nil})
+	assertColumn(t, "replace", col.Replace(word, "$2, $1"), []any{"  Alice Smith ", "jones, bob", "Ünal", nil})
+
+	parts := col.Trim().Split(" ", 2)
+	if len(parts) != 2 {
+		t.Fatalf("expected 2 columns, got %d", len(parts))
+	}
+	assertColumn(t, "first", parts[0], []any{"Alice", "bob-jones", "Ünal", nil})
+	assertColumn(t, "last", parts[1], []any{"Smith", nil, nil, nil})
+
+	all := dataframe.NewString("a-b-c", "d", "e-f").Split("-", -1)
+	if len(all) != 3 {
+		t.Fatalf("expected 3 columns for n < 0, got %d", len(all))
+	}
+	assertColumn(t, "all", all[2], []any{"c", nil, nil})
+	if n := len(col.Split(" ", 0)); n != 0 {
+		t.Errorf("expected no columns for n = 0, got %d", n)
+	}
+	if n := len(dataframe.NewString().Split("-", -1)); n != 0 {
+		t.Errorf("expected no columns for an empty column, got %d", n)
+	}
+
+	short := dataframe.NewString("7", "Ünal", "toolong")
+	short.AppendNull()
+	assertColumn(t, "pad left", short.PadLeft(4, '0'), []any{"0007", "Ünal", "toolong", nil})
+	assertColumn(t, "pad right", short.PadRight(3, '.'), []any{"7..", "Ünal", "toolong", nil})
+	assertColumn(t, "pad", short.Pad(4, '*'), []any{"*7**", "Ünal", "toolong", nil})
+}
+
+func TestStringFilters(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("sku", dataframe.NewString("AB-100", "AB-2", "CD-100", "ab-100"))
+	df.AppendRow(nil)
+
+	if n := df.Filtered(&dataframe.Like{Column: "sku", Pattern: "AB-%"}).Len(); n != 2 {
+		t.Errorf("expected 2 rows like AB-%%, got %d", n)
+	}
+	if n := df.Filtered(&dataframe.Like{Column: "sku", Pattern: "__-1_0"}).Len(); n != 3 {
+		t.Errorf("expected 3 rows like __-1_0, got %d", n)
+	}
+
+	re := &dataframe.Regex{Column: "sku", Pattern: regexp.MustCompile(`(?i)^ab-\d{3}$`)}
+	if n := df.Filtered(re).Len(); n != 2 {
+		t.Errorf("expected 2 rows matching regex, got %d", n)
+	}
+
+	df.AddColumn("cat", df.Column("sku").(*dataframe.String).Categorical())
+	if n := df.Filtered(&dataframe.Like{Column: "cat", Pattern: "AB-%"}).Len(); n != 2 {
+		t.Errorf("expected 2 categorical rows like AB-%%, got %d", n)
+	}
+	if n := df.Filtered(&dataframe.Regex{Column: "cat", Pattern: re.Pattern}).Len(); n != 2 {
+		t.Errorf("expected 2 categorical rows matching regex, got %d", n)
+	}
+
+	like := &dataframe.Like{Column: "cat", Pattern: "AB-%"}
+	df.Filtered(like)
+	like.Pattern = "CD-%"
+	if n := df.Filtered(like).Len(); n != 1 {
+		t.Errorf("expected an edited pattern to match 1 row, got %d", n)
+	}
+}
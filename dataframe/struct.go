@@ -0,0 +1,113 @@
+package dataframe
+
+import "fmt"
+
+// Struct stores a record of named fields per row, backed by one child column
+// per field. A null row has every field null as well.
+type Struct struct {
+	fields *DataFrame
+	nulls  bitmap
+}
+
+// NewStruct uses the columns of fields as the fields of each row.
+func NewStruct(fields *DataFrame) *Struct {
+	return &Struct{fields: fields}
+}
+
+func (col *Struct) New() IColumn {
+	fields := New()
+	for i, name := range col.fields.headers {
+		fields.AddColumn(name, col.fields.data[i].New())
+	}
+	return &Struct{fields: fields}
+}
+
+func (col *Struct) Len() int {
+	return col.fields.rowCount
+}
+
+func (col *Struct) Fields() []string {
+	return col.fields.Headers()
+}
+
+func (col *Struct) Field(name string) IColumn {
+	return col.fields.Column(name)
+}
+
+func (col *Struct) Extend(length int) {
+	if length > col.fields.rowCount {
+		col.nulls.setRange(col.fields.rowCount, length)
+		for _, c := range col.fields.data {
+			c.Extend(length)
+		}
+		col.fields.rowCount = length
+	}
+}
+
+// Index returns the fields of a row as a map[string]any.
+func (col *Struct) Index(idx int) any {
+	if col.nulls.isNull(idx) {
+		return nil
+	}
+
+	record := make(map[string]any, len(col.fields.data))
+	for i, name := range col.fields.headers {
+		record[name] = col.fields.data[i].Index(idx)
+	}
+	return record
+}
+
+func (col *Struct) IsNull(idx int) bool {
+	return col.nulls.isNull(idx)
+}
+
+func (col *Struct) NullCount() int {
+	return col.nulls.count
+}
+
+func (col *Struct) Clone() IColumn {
+	fields := New()
+	for i, name := range col.fields.headers {
+		fields.AddColumn(name, col.fields.data[i].Clone())
+	}
+	fields.rowCount = col.fields.rowCount
+	return &Struct{fields: fields, nulls: col.nulls.clone()}
+}
+
+func (col *Struct) DeleteRow(index int) {
+	col.nulls.deleteRow(index, col.fields.rowCount)
+	for _, c := range col.fields.data {
+		c.DeleteRow(index)
+	}
+	col.fields.rowCount--
+}
+
+// Set stores a map[string]any. Fields missing from the map are null and keys
+// that are not fields are ignored.
+func (col *Struct) Set(index int, value any) {
+	record, ok := value.(map[string]any)
+	if !ok && value != nil {
+		panic(fmt.Errorf("%w: cannot store %T in Struct", ErrTypeMismatch, value))
+	}
+	for i, name := range col.fields.headers {
+		c := col.fields.data[i]
+		c.Set(index, coerce(c, record[name]))
+	}
+	col.nulls.set(index, value == nil)
+}
+
+func (col *Struct) Take(indices []int) IColumn {
+	return &Struct{
+		fields: col.fields.Take(indices),
+		nulls:  takeNulls(&col.nulls, indices),
+	}
+}
+
+func (col *Struct) Append(record map[string]any) {
+	col.Extend(col.fields.rowCount + 1)
+	col.Set(col.fields.rowCount-1, record)
+}
+
+func (col *Struct) AppendNull() {
+	col.Extend(col.fields.rowCount + 1)
+}
@@ -1,90 +1,102 @@
 package dataframe
 
-import (
-	"slices"
-	"sort"
-	"time"
-)
+import "time"
 
 type Time struct {
-	data []time.Time
+	Column[time.Time]
 }
 
 func NewTime(data ...time.Time) *Time {
-	return &Time{
-		data: data,
-	}
+	return &Time{Column[time.Time]{data: data}}
 }
 
 func (col *Time) New() IColumn {
 	return &Time{}
 }
 
-func (col *Time) Len() int {
-	return len(col.data)
+func (col *Time) Clone() IColumn {
+	return &Time{col.clone()}
 }
 
-func (col *Time) Data() []time.Time {
-	return slices.Clone(col.data)
+func (col *Time) Take(indices []int) IColumn {
+	return &Time{col.take(indices)}
 }
 
-func (col *Time) Extend(length int) {
-	diff := length - len(col.data)
-	if diff > 0 {
-		col.data = append(col.data, make([]time.Time, diff)...)
-	}
+func (col *Time) SortBy(asc bool) {
+	sortValues(col.data, &col.nulls, func(a, b time.Time) bool {
+		if asc {
+			return a.Before(b)
+		}
+		return a.After(b)
+	})
 }
 
-func (col *Time) Index(idx int) any {
-	return col.data[idx]
+func (col *Time) Year() *Int {
+	return col.part(func(t time.Time) int64 { return int64(t.Year()) })
 }
 
-func (col *Time) Clone() IColumn {
-	newData := make([]time.Time, len(col.data))
-	copy(newData, col.data)
-	return &Time{data: newData}
+func (col *Time) Month() *Int {
+	return col.part(func(t time.Time) int64 { return int64(t.Month()) })
 }
 
-func (col *Time) DeleteRow(index int) {
-	col.data = append(col.data[:index], col.data[index+1:]...)
+func (col *Time) Day() *Int {
+	return col.part(func(t time.Time) int64 { return int64(t.Day()) })
 }
 
-func (col *Time) Set(index int, value any) {
-	col.data[index] = value.(time.Time)
+// Weekday numbers the days of the week from Sunday as 0, like time.Weekday.
+func (col *Time) Weekday() *Int {
+	return col.part(func(t time.Time) int64 { return int64(t.Weekday()) })
 }
 
-func (col *Time) Append(value time.Time) {
-	col.data = append(col.data, value)
+func (col *Time) Hour() *Int {
+	return col.part(func(t time.Time) int64 { return int64(t.Hour()) })
 }
 
-func (col *Time) Head() (time.Time, bool) {
-	if len(col.data) == 0 {
-		return time.Time{}, false
-	}
-	return col.data[0], true
+func (col *Time) Truncate(d time.Duration) *Time {
+	return col.apply(func(t time.Time) time.Time { return t.Truncate(d) })
 }
 
-func (col *Time) Tail() []time.Time {
-	if len(col.data) <= 1 {
-		return nil
-	}
-
-	return col.data[1:]
+func (col *Time) Round(d time.Duration) *Time {
+	return col.apply(func(t time.Time) time.Time { return t.Round(d) })
 }
 
-func (col *Time) Last() (time.Time, bool) {
-	if len(col.data) == 0 {
-		return time.Time{}, false
-	}
+func (col *Time) Add(d time.Duration) *Time {
+	return col.apply(func(t time.Time) time.Time { return t.Add(d) })
+}
 
-	return col.data[len(col.data)-1], true
+func (col *Time) In(loc *time.Location) *Time {
+	return col.apply(func(t time.Time) time.Time { return t.In(loc) })
 }
 
-func (col *Time) SortBy(asc bool) {
-	sort.Slice(col.data, func(i, j int) bool {
-		if asc {
-			return col.data[i].Before(col.data[j])
+// Sub returns the elapsed time from other to col for every row as nanoseconds,
+// the same representation as time.Duration. Rows where either side is null
+// are null.
+func (col *Time) Sub(other *Time) *Int {
+	sameLength(col, other)
+	out := &Int{Column[int64]{data: make([]int64, len(col.data))}}
+	for i, t := range col.data {
+		if col.nulls.isNull(i) || other.nulls.isNull(i) {
+			out.nulls.set(i, true)
+			continue
 		}
-		return col.data[i].After(col.data[j])
+		out.data[i] = int64(t.Sub(other.data[i]))
+	}
+	return out
+}
+
+func (col *Time) Format(layout string) *String {
+	data, nulls := mapValues(col.data, &col.nulls, func(t time.Time) string {
+		return t.Format(layout)
 	})
+	return &String{Column[string]{data: data, nulls: nulls}}
+}
+
+func (col *Time) part(f func(time.Time) int64) *Int {
+	data, nulls := mapValues(col.data, &col.nulls, f)
+	return &Int{Column[int64]{data: data, nulls: nulls}}
+}
+
+func (col *Time) apply(f func(time.Time) time.Time) *Time {
+	data, nulls := mapValues(col.data, &col.nulls, f)
+	return &Time{Column[time.Time]{data: data, nulls: nulls}}
 }
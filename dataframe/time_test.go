@@ -0,0 +1,56 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"testing"
+	"time"
+)
+
+func TestTimeAccessors(t *testing.T) {
+	col := dataframe.NewTime(time.Date(2024, 2, 29, 13, 40, 0, 0, time.UTC))
+	col.AppendNull()
+
+	assertColumn(t, "year", col.Year(), []any{int64(2024), nil})
+	assertColumn(t, "month", col.Month(), []any{int64(2), nil})
+	assertColumn(t, "day", col.Day(), []any{int64(29), nil})
+	assertColumn(t, "weekday", col.Weekday(), []any{int64(time.Thursday), nil})
+	assertColumn(t, "hour", col.Hour(), []any{int64(13), nil})
+
+	assertColumn(t, "truncate", col.Truncate(time.Hour),
+		[]any{time.Date(2024, 2, 29, 13, 0, 0, 0, time.UTC), nil})
+	assertColumn(t, "round", col.Round(time.Hour),
+		[]any{time.Date(2024, 2, 29, 14, 0, 0, 0, time.UTC), nil})
+	assertColumn(t, "add", col.Add(24*time.Hour),
+		[]any{time.Date(2024, 3, 1, 13, 40, 0, 0, time.UTC), nil})
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	assertColumn(t, "in", col.In(tokyo).Hour(), []any{int64(22), nil})
+	assertColumn(t, "format", col.In(tokyo).Format("2006-01-02 15:04"), []any{"2024-02-29 22:40", nil})
+}
+
+func TestTimeSub(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := dataframe.NewTime(start.Add(90*time.Minute), start)
+	b := dataframe.NewTime(start, start)
+	b.AppendNull()
+	a.AppendNull()
+	b.Set(1, nil)
+
+	assertColumn(t, "sub", a.Sub(b), []any{int64(90 * time.Minute), nil, nil})
+}
+
+func TestParseTime(t *testing.T) {
+	col := dataframe.NewString("2024-03-01 08:15", "")
+	col.AppendNull()
+
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	parsed, err := col.ParseTime("2006-01-02 15:04", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertColumn(t, "parsed", parsed, []any{time.Date(2024, 3, 1, 8, 15, 0, 0, loc), nil, nil})
+
+	if _, err := dataframe.NewString("March").ParseTime("2006-01-02", nil); err == nil {
+		t.Error("expected a parse error")
+	}
+}
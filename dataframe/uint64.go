@@ -0,0 +1,30 @@
+package dataframe
+
+type Uint64 struct {
+	Column[uint64]
+}
+
+func NewUint64(data ...uint64) *Uint64 {
+	return &Uint64{Column[uint64]{data: data}}
+}
+
+func (col *Uint64) New() IColumn {
+	return &Uint64{}
+}
+
+func (col *Uint64) Clone() IColumn {
+	return &Uint64{col.clone()}
+}
+
+func (col *Uint64) Take(indices []int) IColumn {
+	return &Uint64{col.take(indices)}
+}
+
+func (col *Uint64) SortBy(asc bool) {
+	sortValues(col.data, &col.nulls, func(a, b uint64) bool {
+		if asc {
+			return a < b
+		}
+		return a > b
+	})
+}
@@ -0,0 +1,320 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Window computes statistics over a sliding or growing set of rows of a
+// numeric column. Windows never cross group boundaries and their results
+// line up with the rows of the frame they were built from.
+type Window struct {
+	values     []float64
+	nulls      *bitmap
+	groups     [][]int
+	size       int
+	period     time.Duration
+	times      *Time
+	minPeriods int
+}
+
+func (df *DataFrame) Rolling(column string, size int) *Window {
+	return newWindow(df, column, df.allRows()).rows(size)
+}
+
+func (df *DataFrame) RollingTime(column, timeColumn string, period time.Duration) *Window {
+	return newWindow(df, column, df.allRows()).over(df, timeColumn, period)
+}
+
+func (df *DataFrame) Expanding(column string) *Window {
+	return newWindow(df, column, df.allRows())
+}
+
+func (df *DataFrame) EWM(column string, alpha float64) *Float {
+	return newWindow(df, column, df.allRows()).ewm(alpha)
+}
+
+func (g *GroupBy) Rolling(column string, size int) *Window {
+	return newWindow(g.df, column, g.groups).rows(size)
+}
+
+func (g *GroupBy) RollingTime(column, timeColumn string, period time.Duration) *Window {
+	return newWindow(g.df, column, g.groups).over(g.df, timeColumn, period)
+}
+
+func (g *GroupBy) Expanding(column string) *Window {
+	return newWindow(g.df, column, g.groups)
+}
+
+func (g *GroupBy) EWM(column string, alpha float64) *Float {
+	return newWindow(g.df, column, g.groups).ewm(alpha)
+}
+
+func (w *Window) MinPeriods(n int) *Window {
+	w.minPeriods = n
+	return w
+}
+
+func (w *Window) Sum() *Float {
+	var sum float64
+	return w.reduce(
+		func(v float64) { sum += v },
+		func(v float64) { sum -= v },
+		func(n int) float64 { return sum },
+	)
+}
+
+func (w *Window) Mean() *Float {
+	var sum float64
+	return w.reduce(
+		func(v float64) { sum += v },
+		func(v float64) { sum -= v },
+		func(n int) float64 { return sum / float64(n) },
+	)
+}
+
+func (w *Window) Min() *Float {
+	return w.extreme(func(a, b float64) bool { return a < b })
+}
+
+func (w *Window) Max() *Float {
+	return w.extreme(func(a, b float64) bool { return a > b })
+}
+
+// Std keeps a running mean and sum of squared deviations, updated as values
+// enter and leave the window.
+func (w *Window) Std() *Float {
+	var mean, m2 float64
+	var count int
+	return w.reduce(
+		func(v float64) {
+			count++
+			d := v - mean
+			mean += d / float64(count)
+			m2 += d * (v - mean)
+		},
+		func(v float64) {
+			count--
+			if count == 0 {
+				mean, m2 = 0, 0
+				return
+			}
+			d := v - mean
+			mean -= d / float64(count)
+			m2 -= d * (v - mean)
+		},
+		func(n int) float64 {
+			if n < 2 {
+				return math.NaN()
+			}
+			return math.Sqrt(max(m2, 0) / float64(n-1))
+		},
+	)
+}
+
+func (w *Window) Median() *Float {
+	return w.Apply(func(values []float64) float64 {
+		return NewFloat(values...).Median()
+	})
+}
+
+// Apply reduces the non-null values of every window with f. Windows holding
+// fewer values than MinPeriods produce a null. f gets a copy of the values,
+// so it may reorder them.
+func (w *Window) Apply(f func(values []float64) float64) *Float {
+	var window, buf []float64
+	return w.reduce(
+		func(v float64) { window = append(window, v) },
+		func(float64) { window = window[1:] },
+		func(int) float64 {
+			buf = append(buf[:0], window...)
+			return f(buf)
+		},
+	)
+}
+
+// extreme keeps the values that can still become the first by before in a
+// queue, so the first of the window is always at its front.
+func (w *Window) extreme(before func(a, b float64) bool) *Float {
+	var queue []float64
+	return w.reduce(
+		func(v float64) {
+			for len(queue) > 0 && before(v, queue[len(queue)-1]) {
+				queue = queue[:len(queue)-1]
+			}
+			queue = append(queue, v)
+		},
+		func(v float64) {
+			// NaN never compares equal, so match it by being NaN as well
+			if front := queue[0]; front == v || (front != front && v != v) {
+				queue = queue[1:]
+			}
+		},
+		func(int) float64 { return queue[0] },
+	)
+}
+
+// reduce walks the windows of every group in order. Each non-null value is
+// passed to add when it enters a window and to drop when it leaves it, and
+// value gives the result for a window holding n values.
+func (w *Window) reduce(add, drop func(v float64), value func(n int) float64) *Float {
+	out := NewFloat()
+	out.Extend(len(w.values))
+
+	minPeriods := max(w.minPeriods, 1)
+	for _, rows := range w.groups {
+		start, end, n := 0, 0, 0
+		leave := func() {
+			if j := rows[start]; !w.nulls.isNull(j) {
+				drop(w.values[j])
+				n--
+			}
+			start++
+		}
+
+		for k, row := range rows {
+			if w.times != nil && w.times.nulls.isNull(row) {
+				continue
+			}
+
+			for ; end <= k; end++ {
+				if j := rows[end]; !w.nulls.isNull(j) {
+					add(w.values[j])
+					n++
+				}
+			}
+			for start < k && w.expired(rows[start], row, k-start) {
+				leave()
+			}
+
+			if n >= minPeriods {
+				out.Set(row, value(n))
+			}
+		}
+
+		// empty the window so the next group starts afresh
+		for start < end {
+			leave()
+		}
+	}
+
+	return out
+}
+
+// expired reports whether the row first, lag rows before row, has left the
+// window ending at row.
+func (w *Window) expired(first, row, lag int) bool {
+	switch {
+	case w.times != nil:
+		return w.times.nulls.isNull(first) ||
+			!w.times.data[first].After(w.times.data[row].Add(-w.period))
+	case w.size > 0:
+		return lag >= w.size
+	default:
+		return false
+	}
+}
+
+func (w *Window) ewm(alpha float64) *Float {
+	out := NewFloat()
+	out.Extend(len(w.values))
+
+	for _, rows := range w.groups {
+		var avg float64
+		started := false
+
+		for _, row := range rows {
+			if w.nulls.isNull(row) {
+				continue
+			}
+
+			if !started {
+				avg = w.values[row]
+				started = true
+			} else {
+				avg = alpha*w.values[row] + (1-alpha)*avg
+			}
+			out.Set(row, avg)
+		}
+	}
+
+	return out
+}
+
+func newWindow(df *DataFrame, column string, groups [][]int) *Window {
+	w := &Window{groups: groups}
+
+	switch c := df.Column(column).(type) {
+	case *Float:
+		w.values, w.nulls = c.data, &c.nulls
+	case *Int:
+		w.values, w.nulls = floatValues(c.data), &c.nulls
+	case *Int32:
+		w.values, w.nulls = floatValues(c.data), &c.nulls
+	case *Uint64:
+		w.values, w.nulls = floatValues(c.data), &c.nulls
+	case *Float32:
+		w.values, w.nulls = floatValues(c.data), &c.nulls
+	case *Decimal:
+		w.values, w.nulls = floatValues(c.data), &c.nulls
+		for i := range w.values {
+			w.values[i] /= float64(pow10[c.scale])
+		}
+	default:
+		panic(fmt.Errorf("%w: window functions require a numeric column", ErrTypeMismatch))
+	}
+
+	return w
+}
+
+func (w *Window) rows(size int) *Window {
+	if size <= 0 {
+		panic(fmt.Errorf("%w: window size %d must be positive", ErrInvalidArgument, size))
+	}
+
+	w.size = size
+	w.minPeriods = size
+	return w
+}
+
+func (w *Window) over(df *DataFrame, timeColumn string, period time.Duration) *Window {
+	times, ok := df.Column(timeColumn).(*Time)
+	if !ok {
+		panic(fmt.Errorf("%w: time window requires a Time column", ErrTypeMismatch))
+	}
+
+	for _, rows := range w.groups {
+		var last time.Time
+		for k, row := range rows {
+			if times.nulls.isNull(row) {
+				continue
+			}
+			if k > 0 && times.data[row].Before(last) {
+				panic(fmt.Errorf("%w: time window requires rows sorted by time", ErrInvalidArgument))
+			}
+			last = times.data[row]
+		}
+	}
+
+	w.times = times
+	w.period = period
+	return w
+}
+
+func (df *DataFrame) allRows() [][]int {
+	rows := make([]int, df.rowCount)
+	for i := range rows {
+		rows[i] = i
+	}
+
+	return [][]int{rows}
+}
+
+func floatValues[T number](data []T) []float64 {
+	values := make([]float64, len(data))
+	for i, v := range data {
+		values[i] = float64(v)
+	}
+	return values
+}
@@ -0,0 +1,105 @@
+package dataframe_test
+
+import (
+	"go-numeric/dataframe"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func assertFloats(t *testing.T, name string, col *dataframe.Float, want []any) {
+	t.Helper()
+
+	if col.Len() != len(want) {
+		t.Fatalf("%s: expected %d values, got %d", name, len(want), col.Len())
+	}
+
+	for i, v := range want {
+		got := col.Index(i)
+		if v == nil || got == nil {
+			if v != got {
+				t.Errorf("%s[%d]: expected %v, got %v", name, i, v, got)
+			}
+			continue
+		}
+		if math.Abs(got.(float64)-v.(float64)) > 1e-9 {
+			t.Errorf("%s[%d]: expected %v, got %v", name, i, v, got)
+		}
+	}
+}
+
+func TestRolling(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("x", dataframe.NewInt(1, 2, 3))
+	df.AppendRow(nil)
+	df.AppendRow(5)
+
+	assertFloats(t, "sum", df.Rolling("x", 2).Sum(), []any{nil, 3.0, 5.0, nil, nil})
+	assertFloats(t, "mean", df.Rolling("x", 2).MinPeriods(1).Mean(), []any{1.0, 1.5, 2.5, 3.0, 5.0})
+	assertFloats(t, "max", df.Rolling("x", 3).Max(), []any{nil, nil, 3.0, nil, nil})
+	assertFloats(t, "median", df.Rolling("x", 3).MinPeriods(2).Median(), []any{nil, 1.5, 2.0, 2.5, 4.0})
+	assertFloats(t, "expanding", df.Expanding("x").Sum(), []any{1.0, 3.0, 6.0, 6.0, 11.0})
+	assertFloats(t, "custom", df.Rolling("x", 2).Apply(func(v []float64) float64 {
+		return v[len(v)-1] - v[0]
+	}), []any{nil, 1.0, 1.0, nil, nil})
+	assertFloats(t, "ewm", df.EWM("x", 0.5), []any{1.0, 1.5, 2.25, nil, 3.625})
+}
+
+func TestRollingTime(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	df := dataframe.New()
+	df.AddColumn("at", dataframe.NewTime(
+		start,
+		start.Add(time.Minute),
+		start.Add(3*time.Minute),
+		start.Add(4*time.Minute),
+	))
+	df.AddColumn("x", dataframe.NewFloat(1, 2, 4, 8))
+
+	assertFloats(t, "sum", df.RollingTime("x", "at", 2*time.Minute).Sum(), []any{1.0, 3.0, 4.0, 12.0})
+	assertFloats(t, "min", df.RollingTime("x", "at", 5*time.Minute).Min(), []any{1.0, 1.0, 1.0, 1.0})
+}
+
+func TestRollingGroups(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("g", dataframe.NewString("a", "b", "a", "b", "a"))
+	df.AddColumn("x", dataframe.NewFloat(1, 10, 2, 20, 3))
+
+	g := df.GroupBy("g")
+	assertFloats(t, "sum", g.Rolling("x", 2).Sum(), []any{nil, nil, 3.0, 30.0, 5.0})
+	assertFloats(t, "expanding", g.Expanding("x").Mean(), []any{1.0, 10.0, 1.5, 15.0, 2.0})
+	assertFloats(t, "std", g.Expanding("x").MinPeriods(2).Std(), []any{nil, nil, math.Sqrt(0.5), math.Sqrt(50), 1.0})
+}
+
+func TestRollingMatchesApply(t *testing.T) {
+	df := dataframe.New()
+	df.AddColumn("g", dataframe.NewString())
+	df.AddColumn("x", dataframe.NewFloat())
+	for i := range 200 {
+		var x any = float64((i*37)%23) - 11
+		if i%7 == 3 {
+			x = nil
+		}
+		df.AppendRow([]string{"a", "b", "c"}[i%3], x)
+	}
+
+	std := func(v []float64) float64 { return dataframe.NewFloat(v...).Std() }
+	for name, w := range map[string]func() *dataframe.Window{
+		"rolling":   func() *dataframe.Window { return df.GroupBy("g").Rolling("x", 5).MinPeriods(1) },
+		"expanding": func() *dataframe.Window { return df.GroupBy("g").Expanding("x") },
+	} {
+		for stat, pair := range map[string][2]*dataframe.Float{
+			"min": {w().Min(), w().Apply(slices.Min[[]float64])},
+			"max": {w().Max(), w().Apply(slices.Max[[]float64])},
+			"std": {w().Std(), w().Apply(std)},
+		} {
+			want := make([]any, pair[1].Len())
+			for i := range want {
+				want[i] = pair[1].Index(i)
+			}
+			assertFloats(t, name+" "+stat, pair[0], want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package plotter
 import (
 	"fmt"
 	"io"
+	"math"
 	"os"
 
 	"github.com/ajstarks/svgo"
@@ -73,6 +74,12 @@ func (h *Heatmap) drawHeatmap(canvas *svg.SVG) {
 			x := h.padding + h.labelSpace + j*cellWidth
 			y := h.padding + i*cellHeight
 
+			// Missing cells, e.g. from a pivot, are drawn blank.
+			if math.IsNaN(val) {
+				canvas.Rect(x, y, cellWidth, cellHeight, "fill:lightgray;stroke:black;stroke-width:1")
+				continue
+			}
+
 			canvas.Rect(x, y, cellWidth, cellHeight, fmt.Sprintf("fill:%s;stroke:black;stroke-width:1", color))
 
 			textColor := "black"
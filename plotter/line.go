@@ -3,6 +3,7 @@ package plotter
 import (
 	"fmt"
 	"io"
+	"math"
 	"os"
 
 	"github.com/ajstarks/svgo"
@@ -109,17 +110,26 @@ func (p *LinePlot) drawAxes(canvas *svg.SVG) {
 
 func (p *LinePlot) drawSeries(canvas *svg.SVG) {
 	for _, s := range p.series {
+		// NaN points are gaps: no marker and no segment to or from them
 		for i := 1; i < len(s.x); i++ {
+			if missing(s.x[i-1], s.y[i-1]) || missing(s.x[i], s.y[i]) {
+				continue
+			}
+
 			x1 := p.scaleX(s.x[i-1])
 			y1 := p.scaleY(s.y[i-1])
 			x2 := p.scaleX(s.x[i])
 			y2 := p.scaleY(s.y[i])
 
 			canvas.Line(x1, y1, x2, y2, fmt.Sprintf("stroke:%s;stroke-width:2", s.color))
+		}
 
-			// Draw marker (circles for now)
-			canvas.Circle(x1, y1, 3, fmt.Sprintf("fill:%s", s.color))
-			canvas.Circle(x2, y2, 3, fmt.Sprintf("fill:%s", s.color))
+		// Draw marker (circles for now)
+		for i := range s.x {
+			if missing(s.x[i], s.y[i]) {
+				continue
+			}
+			canvas.Circle(p.scaleX(s.x[i]), p.scaleY(s.y[i]), 3, fmt.Sprintf("fill:%s", s.color))
 		}
 	}
 }
@@ -131,3 +141,7 @@ func (p *LinePlot) scaleX(x float64) int {
 func (p *LinePlot) scaleY(y float64) int {
 	return p.height - p.padding - int(((y-p.yStart)/(p.yEnd-p.yStart))*float64(p.height-2*p.padding))
 }
+
+func missing(x, y float64) bool {
+	return math.IsNaN(x) || math.IsNaN(y)
+}